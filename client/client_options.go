@@ -0,0 +1,203 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ClientOption configures the client built by NewSoftLayerClient.
+type ClientOption func(*softLayerClient)
+
+type httpClientConfig struct {
+	httpClient *http.Client
+	transport  http.RoundTripper
+
+	requestTimeout time.Duration
+	dialTimeout    time.Duration
+
+	tlsConfig *tls.Config
+	rootCAs   *x509.CertPool
+}
+
+// WithEndpoint sends requests to endpoint instead of SOFTLAYER_API_URL, e.g.
+// SOFTLAYER_PRIVATE_API_URL or the URL of a local stand-in server. The scheme
+// defaults to https.
+func WithEndpoint(endpoint string) ClientOption {
+	return func(slc *softLayerClient) {
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+
+		slc.endpoint = strings.TrimSuffix(endpoint, "/")
+	}
+}
+
+// WithXmlRpc sends requests to the XML-RPC API at endpoint, by default
+// SOFTLAYER_XMLRPC_API_URL, instead of the REST API. The services work the
+// same over both.
+func WithXmlRpc(endpoint string) ClientOption {
+	return func(slc *softLayerClient) {
+		if endpoint == "" {
+			endpoint = SOFTLAYER_XMLRPC_API_URL
+		}
+
+		WithEndpoint(endpoint)(slc)
+		slc.transport = &xmlRpcTransport{client: slc}
+	}
+}
+
+// WithRequestTimeout bounds the time taken by each request, including reading
+// the response body.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.httpClientConfig.requestTimeout = timeout
+	}
+}
+
+// WithDialTimeout bounds the time taken to open a connection to the API.
+func WithDialTimeout(timeout time.Duration) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.httpClientConfig.dialTimeout = timeout
+	}
+}
+
+// WithHTTPClient makes the client send its requests with a copy of httpClient.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.httpClientConfig.httpClient = httpClient
+	}
+}
+
+// WithTransport makes the client send its requests through transport. The
+// dial timeout and TLS options only apply to *http.Transport transports.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.httpClientConfig.transport = transport
+	}
+}
+
+func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.httpClientConfig.tlsConfig = tlsConfig
+	}
+}
+
+// WithRootCAs makes the client trust the certificate authorities in rootCAs,
+// see LoadCABundle, instead of the system ones.
+func WithRootCAs(rootCAs *x509.CertPool) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.httpClientConfig.rootCAs = rootCAs
+	}
+}
+
+// WithUserAgentSuffix appends suffix to the User-Agent sent with each request.
+func WithUserAgentSuffix(suffix string) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.userAgent = fmt.Sprintf("%s %s", SOFTLAYER_GO_USER_AGENT, suffix)
+	}
+}
+
+func WithRetryPolicy(retryPolicy RetryPolicy) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.SetRetryPolicy(retryPolicy)
+	}
+}
+
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.SetRateLimit(requestsPerSecond, burst)
+	}
+}
+
+// WithLogger sends an entry to logger for each request. The client does not
+// log anything by default.
+func WithLogger(logger Logger) ClientOption {
+	return func(slc *softLayerClient) {
+		if logger == nil {
+			logger = noopLogger{}
+		}
+		slc.logger = logger
+	}
+}
+
+// WithLogBodies adds the request headers and the request and response bodies,
+// with credentials and passwords redacted, to the log entries.
+func WithLogBodies(logBodies bool) ClientOption {
+	return func(slc *softLayerClient) {
+		slc.logBodies = logBodies
+	}
+}
+
+// LoadCABundle reads the PEM encoded certificates in path, to be passed to
+// WithRootCAs.
+func LoadCABundle(path string) (*x509.CertPool, error) {
+	pemCerts, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(pemCerts) {
+		return nil, fmt.Errorf("No certificate found in CA bundle '%s'", path)
+	}
+
+	return rootCAs, nil
+}
+
+//Private methods
+
+func (config httpClientConfig) newHttpClient() *http.Client {
+	httpClient := &http.Client{}
+	if config.httpClient != nil {
+		copied := *config.httpClient
+		httpClient = &copied
+	}
+
+	if config.transport != nil {
+		httpClient.Transport = config.transport
+	}
+
+	if httpClient.Transport == nil {
+		httpClient.Transport = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		}
+	}
+
+	if transport, ok := httpClient.Transport.(*http.Transport); ok && config.configuresTransport() {
+		transport = transport.Clone()
+
+		if config.dialTimeout > 0 {
+			transport.DialContext = (&net.Dialer{Timeout: config.dialTimeout}).DialContext
+			transport.TLSHandshakeTimeout = config.dialTimeout
+		}
+
+		if config.tlsConfig != nil {
+			transport.TLSClientConfig = config.tlsConfig.Clone()
+		}
+
+		if config.rootCAs != nil {
+			if transport.TLSClientConfig == nil {
+				transport.TLSClientConfig = &tls.Config{}
+			}
+			transport.TLSClientConfig.RootCAs = config.rootCAs
+		}
+
+		httpClient.Transport = transport
+	}
+
+	if config.requestTimeout > 0 {
+		httpClient.Timeout = config.requestTimeout
+	}
+
+	return httpClient
+}
+
+func (config httpClientConfig) configuresTransport() bool {
+	return config.dialTimeout > 0 || config.tlsConfig != nil || config.rootCAs != nil
+}
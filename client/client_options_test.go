@@ -0,0 +1,389 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type recordingRoundTripper struct {
+	requests []*http.Request
+}
+
+func (rrt *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rrt.requests = append(rrt.requests, req)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`"Active"`)),
+		Request:    req,
+	}, nil
+}
+
+var _ = Describe("ClientOptions", func() {
+	var (
+		server   *httptest.Server
+		handler  http.HandlerFunc
+		requests []*http.Request
+		mutex    sync.Mutex
+
+		noRetries slclient.RetryPolicy
+	)
+
+	BeforeEach(func() {
+		requests = []*http.Request{}
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"id": 1234567}`))
+		}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			requests = append(requests, r)
+			mutex.Unlock()
+
+			handler(w, r)
+		}))
+
+		noRetries = slclient.DefaultRetryPolicy()
+		noRetries.MaxAttempts = 1
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("#WithEndpoint", func() {
+		It("sends the requests to the endpoint with the credentials", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL+"/rest/v3"))
+
+			response, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(Equal([]byte(`{"id": 1234567}`)))
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].URL.Path).To(Equal("/rest/v3/SoftLayer_Account/getAccountStatus.json"))
+
+			username, apiKey, ok := requests[0].BasicAuth()
+			Expect(ok).To(BeTrue())
+			Expect(username).To(Equal("fake-username"))
+			Expect(apiKey).To(Equal("fake-api-key"))
+		})
+
+		It("returns a SoftLayerError for an API exception", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error": "Unable to find object with id of '1234567'.", "code": "SoftLayer_Exception_ObjectNotFound"}`))
+			}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL))
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/getObject.json", "GET", new(bytes.Buffer))
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slErr.StatusCode).To(Equal(http.StatusNotFound))
+			Expect(slErr.Code).To(Equal("SoftLayer_Exception_ObjectNotFound"))
+			Expect(slErr.Message).To(Equal("Unable to find object with id of '1234567'."))
+			Expect(slErr.Service).To(Equal("SoftLayer_Virtual_Guest"))
+			Expect(slErr.Method).To(Equal("getObject"))
+			Expect(slErr.Attempts).To(Equal(1))
+		})
+	})
+
+	Context("#WithUserAgentSuffix", func() {
+		It("sends a softlayer-go User-Agent by default", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL))
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests[0].UserAgent()).To(Equal("softlayer-go"))
+		})
+
+		It("appends the suffix to the User-Agent", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithUserAgentSuffix("bosh-softlayer-cpi/1.0"),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests[0].UserAgent()).To(Equal("softlayer-go bosh-softlayer-cpi/1.0"))
+		})
+	})
+
+	Context("#WithRequestTimeout", func() {
+		It("fails requests taking longer than the timeout", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+			}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithRequestTimeout(50*time.Millisecond),
+				slclient.WithRetryPolicy(noRetries),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Client.Timeout exceeded"))
+		})
+	})
+
+	Context("#WithTransport", func() {
+		It("sends the requests through the transport", func() {
+			transport := &recordingRoundTripper{}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithTransport(transport))
+
+			response, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(Equal([]byte(`"Active"`)))
+
+			Expect(transport.requests).To(HaveLen(1))
+			Expect(transport.requests[0].URL.Host).To(Equal("api.softlayer.com"))
+		})
+
+		It("sends the credentials in the Authorization header rather than in the URL", func() {
+			transport := &recordingRoundTripper{}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithTransport(transport))
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(transport.requests[0].URL.User).To(BeNil())
+			Expect(transport.requests[0].URL.String()).ToNot(ContainSubstring("fake-api-key"))
+
+			username, apiKey, ok := transport.requests[0].BasicAuth()
+			Expect(ok).To(BeTrue())
+			Expect(username).To(Equal("fake-username"))
+			Expect(apiKey).To(Equal("fake-api-key"))
+		})
+	})
+
+	Context("#WithHTTPClient", func() {
+		It("sends the requests with the http.Client", func() {
+			transport := &recordingRoundTripper{}
+			httpClient := &http.Client{Transport: transport}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithHTTPClient(httpClient),
+				slclient.WithEndpoint(slclient.SOFTLAYER_PRIVATE_API_URL),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(transport.requests).To(HaveLen(1))
+			Expect(transport.requests[0].URL.Scheme).To(Equal("https"))
+			Expect(transport.requests[0].URL.Host).To(Equal("api.service.softlayer.com"))
+		})
+	})
+
+	Context("#WithRetryPolicy", func() {
+		var retryPolicy slclient.RetryPolicy
+
+		BeforeEach(func() {
+			retryPolicy = slclient.DefaultRetryPolicy()
+			retryPolicy.BaseBackoff = time.Millisecond
+
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				if len(requests) < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Write([]byte(`{"id": 1234567}`))
+			}
+		})
+
+		It("retries transient failures", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithRetryPolicy(retryPolicy),
+			)
+
+			stats := slclient.RequestStats{}
+			ctx := slclient.WithRequestStats(context.Background(), &stats)
+
+			response, err := client.DoRawHttpRequestWithContext(ctx, "SoftLayer_Virtual_Guest/1234567/getObject.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(Equal([]byte(`{"id": 1234567}`)))
+			Expect(requests).To(HaveLen(3))
+			Expect(stats.Attempts).To(Equal(3))
+		})
+
+		It("resends the request body on each attempt", func() {
+			bodies := []string{}
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+				if len(requests) < 2 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}
+			}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithRetryPolicy(retryPolicy),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Network_Subnet/getSubnetForIpAddress.json", "POST", bytes.NewBufferString(`{"parameters": ["10.0.0.1"]}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bodies).To(Equal([]string{`{"parameters": ["10.0.0.1"]}`, `{"parameters": ["10.0.0.1"]}`}))
+		})
+
+		It("gives up after MaxAttempts and reports the attempts made", func() {
+			retryPolicy.MaxAttempts = 2
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithRetryPolicy(retryPolicy),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/getObject.json", "GET", new(bytes.Buffer))
+			Expect(err).To(HaveOccurred())
+			Expect(requests).To(HaveLen(2))
+
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slErr.StatusCode).To(Equal(http.StatusServiceUnavailable))
+			Expect(slErr.Attempts).To(Equal(2))
+		})
+
+		It("does not retry placing an order", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithRetryPolicy(retryPolicy),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Product_Order/placeOrder.json", "POST", bytes.NewBufferString(`{}`))
+			Expect(err).To(HaveOccurred())
+			Expect(requests).To(HaveLen(1))
+		})
+
+		It("does not retry configuring the metadata disk after a transport error", func() {
+			attempts := make(chan bool, 10)
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				attempts <- true
+				hijacker, _ := w.(http.Hijacker)
+				conn, _, _ := hijacker.Hijack()
+				conn.Close()
+			}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithRetryPolicy(retryPolicy),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/configureMetadataDisk.json", "POST", new(bytes.Buffer))
+			Expect(err).To(HaveOccurred())
+			Expect(attempts).To(HaveLen(1))
+
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slErr.StatusCode).To(Equal(0))
+			Expect(slErr.Attempts).To(Equal(1))
+		})
+	})
+
+	Context("#SetRateLimit", func() {
+		It("may be changed while requests are sent", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithRetryPolicy(noRetries),
+			)
+
+			done := make(chan error)
+			for i := 0; i < 4; i++ {
+				go func() {
+					_, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+					done <- err
+				}()
+			}
+
+			for i := 0; i < 4; i++ {
+				client.SetRateLimit(float64(1000+i), 10)
+				client.SetRetryPolicy(noRetries)
+			}
+
+			for i := 0; i < 4; i++ {
+				Expect(<-done).ToNot(HaveOccurred())
+			}
+		})
+	})
+
+	Context("#WithRootCAs", func() {
+		var tlsServer *httptest.Server
+
+		BeforeEach(func() {
+			tlsServer = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`"Active"`))
+			}))
+		})
+
+		AfterEach(func() {
+			tlsServer.Close()
+		})
+
+		It("does not trust an unknown certificate authority", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(tlsServer.URL),
+				slclient.WithRetryPolicy(noRetries),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("trusts the certificate authorities loaded from a CA bundle", func() {
+			tmpDir, err := ioutil.TempDir("", "softlayer-go")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(tmpDir)
+
+			caBundlePath := filepath.Join(tmpDir, "ca.pem")
+			caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+			err = ioutil.WriteFile(caBundlePath, caBundle, 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			rootCAs, err := slclient.LoadCABundle(caBundlePath)
+			Expect(err).ToNot(HaveOccurred())
+
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(tlsServer.URL),
+				slclient.WithRootCAs(rootCAs),
+				slclient.WithDialTimeout(time.Second),
+			)
+
+			response, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(Equal([]byte(`"Active"`)))
+		})
+
+		It("uses the TLS config", func() {
+			rootCAs := x509.NewCertPool()
+			rootCAs.AddCert(tlsServer.Certificate())
+
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(tlsServer.URL),
+				slclient.WithTLSConfig(&tls.Config{RootCAs: rootCAs}),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("fails to load a CA bundle without certificates", func() {
+			_, err := slclient.LoadCABundle("fake-ca-bundle-path")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
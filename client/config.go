@@ -0,0 +1,208 @@
+package client
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	SL_USERNAME     = "SL_USERNAME"
+	SL_API_KEY      = "SL_API_KEY"
+	SL_ENDPOINT_URL = "SL_ENDPOINT_URL"
+	SL_PROFILE      = "SL_PROFILE"
+	SL_CONFIG_FILE  = "SL_CONFIG_FILE"
+
+	SOFTLAYER_CONFIG_FILE     = ".softlayer"
+	SOFTLAYER_DEFAULT_PROFILE = "softlayer"
+)
+
+// Config holds the settings needed to build a client, along with where each
+// one came from. Values are taken, in order of precedence, from the SL_*
+// environment variables, then from the profile section of the ~/.softlayer
+// INI file, then from the defaults.
+type Config struct {
+	Username    string
+	ApiKey      string
+	EndpointUrl string
+
+	Profile    string
+	ConfigFile string
+
+	UsernameSource    string
+	ApiKeySource      string
+	EndpointUrlSource string
+}
+
+// LoadConfig loads the configuration for profile, or for the SL_PROFILE
+// profile when empty, from the environment and the SL_CONFIG_FILE or
+// ~/.softlayer file.
+func LoadConfig(profile string) (Config, error) {
+	configFile := os.Getenv(SL_CONFIG_FILE)
+	if configFile == "" {
+		homeDir, err := os.UserHomeDir()
+		if err == nil {
+			configFile = filepath.Join(homeDir, SOFTLAYER_CONFIG_FILE)
+		}
+	}
+
+	return LoadConfigFromFile(configFile, profile)
+}
+
+// LoadConfigFromFile is LoadConfig reading the INI file at configFile.
+func LoadConfigFromFile(configFile string, profile string) (Config, error) {
+	explicitProfile := profile != "" || os.Getenv(SL_PROFILE) != ""
+	if profile == "" {
+		profile = os.Getenv(SL_PROFILE)
+	}
+	if profile == "" {
+		profile = SOFTLAYER_DEFAULT_PROFILE
+	}
+
+	config := Config{
+		EndpointUrl:       fmt.Sprintf("https://%s", SOFTLAYER_API_URL),
+		EndpointUrlSource: "default",
+
+		Profile:    profile,
+		ConfigFile: configFile,
+	}
+
+	sections, err := readConfigFile(configFile)
+	if err != nil && !os.IsNotExist(err) {
+		return Config{}, err
+	}
+
+	values, ok := sections[profile]
+	if !ok && explicitProfile {
+		return Config{}, errors.New(fmt.Sprintf("Profile '%s' not found in config file '%s'", profile, configFile))
+	}
+
+	fileSource := fmt.Sprintf("%s [%s]", configFile, profile)
+	config.setFromFile(values, fileSource)
+	config.setFromEnvironment()
+
+	if config.Username == "" {
+		return Config{}, errors.New(fmt.Sprintf("SoftLayer username not found, set %s or 'username' in %s", SL_USERNAME, fileSource))
+	}
+
+	if config.ApiKey == "" {
+		return Config{}, errors.New(fmt.Sprintf("SoftLayer API key not found, set %s or 'api_key' in %s", SL_API_KEY, fileSource))
+	}
+
+	return config, nil
+}
+
+// NewSoftLayerClientFromEnvironment builds a client from LoadConfig("").
+// Options are applied after the loaded endpoint and may override it.
+func NewSoftLayerClientFromEnvironment(options ...ClientOption) (*softLayerClient, error) {
+	config, err := LoadConfig("")
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSoftLayerClientFromConfig(config, options...), nil
+}
+
+func NewSoftLayerClientFromConfig(config Config, options ...ClientOption) *softLayerClient {
+	options = append([]ClientOption{WithEndpoint(config.EndpointUrl)}, options...)
+
+	return NewSoftLayerClient(config.Username, config.ApiKey, options...)
+}
+
+// String describes the configuration and the source of each value; the API
+// key itself is never included.
+func (c Config) String() string {
+	apiKey := ""
+	if c.ApiKey != "" {
+		apiKey = "********"
+	}
+
+	return fmt.Sprintf("profile: %s\nusername: %s (from %s)\napi key: %s (from %s)\nendpoint url: %s (from %s)",
+		c.Profile,
+		c.Username, c.UsernameSource,
+		apiKey, c.ApiKeySource,
+		c.EndpointUrl, c.EndpointUrlSource,
+	)
+}
+
+func (c Config) GoString() string {
+	return c.String()
+}
+
+//Private methods
+
+func (c *Config) setFromFile(values map[string]string, source string) {
+	if username := values["username"]; username != "" {
+		c.Username, c.UsernameSource = username, source
+	}
+
+	if apiKey := values["api_key"]; apiKey != "" {
+		c.ApiKey, c.ApiKeySource = apiKey, source
+	}
+
+	if endpointUrl := values["endpoint_url"]; endpointUrl != "" {
+		c.EndpointUrl, c.EndpointUrlSource = restEndpointUrl(endpointUrl), source
+	}
+}
+
+func (c *Config) setFromEnvironment() {
+	if username := os.Getenv(SL_USERNAME); username != "" {
+		c.Username, c.UsernameSource = username, fmt.Sprintf("environment variable %s", SL_USERNAME)
+	}
+
+	if apiKey := os.Getenv(SL_API_KEY); apiKey != "" {
+		c.ApiKey, c.ApiKeySource = apiKey, fmt.Sprintf("environment variable %s", SL_API_KEY)
+	}
+
+	if endpointUrl := os.Getenv(SL_ENDPOINT_URL); endpointUrl != "" {
+		c.EndpointUrl, c.EndpointUrlSource = restEndpointUrl(endpointUrl), fmt.Sprintf("environment variable %s", SL_ENDPOINT_URL)
+	}
+}
+
+// The other SoftLayer tools usually configure the XML-RPC endpoint, which
+// serves the REST API under /rest/ instead of /xmlrpc/.
+func restEndpointUrl(endpointUrl string) string {
+	return strings.TrimSuffix(strings.Replace(endpointUrl, "/xmlrpc/", "/rest/", 1), "/")
+}
+
+func readConfigFile(configFile string) (map[string]map[string]string, error) {
+	sections := map[string]map[string]string{}
+	if configFile == "" {
+		return sections, nil
+	}
+
+	file, err := os.Open(configFile)
+	if err != nil {
+		return sections, err
+	}
+	defer file.Close()
+
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := sections[section]; !ok {
+				sections[section] = map[string]string{}
+			}
+		default:
+			separator := strings.IndexAny(line, "=:")
+			if separator < 0 || section == "" {
+				return sections, errors.New(fmt.Sprintf("Invalid line %d in config file '%s'", lineNumber, configFile))
+			}
+
+			key := strings.ToLower(strings.TrimSpace(line[:separator]))
+			sections[section][key] = strings.TrimSpace(line[separator+1:])
+		}
+	}
+
+	return sections, scanner.Err()
+}
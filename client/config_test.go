@@ -0,0 +1,180 @@
+package client_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("Config", func() {
+	var (
+		tmpDir     string
+		configFile string
+
+		savedEnv map[string]string
+	)
+
+	BeforeEach(func() {
+		savedEnv = map[string]string{}
+		for _, name := range []string{"SL_USERNAME", "SL_API_KEY", "SL_ENDPOINT_URL", "SL_PROFILE", "SL_CONFIG_FILE"} {
+			savedEnv[name] = os.Getenv(name)
+			os.Unsetenv(name)
+		}
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "softlayer-go")
+		Expect(err).ToNot(HaveOccurred())
+
+		configFile = filepath.Join(tmpDir, ".softlayer")
+		err = ioutil.WriteFile(configFile, []byte(`
+[softlayer]
+username = file-username
+api_key = file-api-key
+endpoint_url = https://api.service.softlayer.com/xmlrpc/v3/
+
+# a second account
+[staging]
+username = staging-username
+api_key = staging-api-key
+`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		for name, value := range savedEnv {
+			os.Setenv(name, value)
+		}
+
+		os.RemoveAll(tmpDir)
+	})
+
+	Context("#LoadConfigFromFile", func() {
+		It("reads the default profile of the config file", func() {
+			config, err := slclient.LoadConfigFromFile(configFile, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.Profile).To(Equal("softlayer"))
+			Expect(config.Username).To(Equal("file-username"))
+			Expect(config.ApiKey).To(Equal("file-api-key"))
+			Expect(config.EndpointUrl).To(Equal("https://api.service.softlayer.com/rest/v3"))
+
+			source := fmt.Sprintf("%s [softlayer]", configFile)
+			Expect(config.UsernameSource).To(Equal(source))
+			Expect(config.ApiKeySource).To(Equal(source))
+			Expect(config.EndpointUrlSource).To(Equal(source))
+		})
+
+		It("reads a named profile", func() {
+			config, err := slclient.LoadConfigFromFile(configFile, "staging")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.Username).To(Equal("staging-username"))
+			Expect(config.ApiKey).To(Equal("staging-api-key"))
+			Expect(config.EndpointUrl).To(Equal("https://api.softlayer.com/rest/v3"))
+			Expect(config.EndpointUrlSource).To(Equal("default"))
+		})
+
+		It("reads the profile named by SL_PROFILE", func() {
+			os.Setenv("SL_PROFILE", "staging")
+
+			config, err := slclient.LoadConfigFromFile(configFile, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Username).To(Equal("staging-username"))
+		})
+
+		It("fails when the named profile does not exist", func() {
+			_, err := slclient.LoadConfigFromFile(configFile, "fake-profile")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Profile 'fake-profile' not found"))
+		})
+
+		It("prefers the environment variables over the config file", func() {
+			os.Setenv("SL_USERNAME", "env-username")
+			os.Setenv("SL_ENDPOINT_URL", "http://127.0.0.1:8080")
+
+			config, err := slclient.LoadConfigFromFile(configFile, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.Username).To(Equal("env-username"))
+			Expect(config.UsernameSource).To(Equal("environment variable SL_USERNAME"))
+			Expect(config.ApiKey).To(Equal("file-api-key"))
+			Expect(config.EndpointUrl).To(Equal("http://127.0.0.1:8080"))
+			Expect(config.EndpointUrlSource).To(Equal("environment variable SL_ENDPOINT_URL"))
+		})
+
+		It("only needs the environment variables when there is no config file", func() {
+			os.Setenv("SL_USERNAME", "env-username")
+			os.Setenv("SL_API_KEY", "env-api-key")
+
+			config, err := slclient.LoadConfigFromFile(filepath.Join(tmpDir, "fake-config-file"), "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Username).To(Equal("env-username"))
+			Expect(config.ApiKey).To(Equal("env-api-key"))
+		})
+
+		It("fails without an API key", func() {
+			os.Setenv("SL_USERNAME", "env-username")
+
+			_, err := slclient.LoadConfigFromFile(filepath.Join(tmpDir, "fake-config-file"), "")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("SoftLayer API key not found"))
+		})
+
+		It("fails on a malformed config file without printing its content", func() {
+			err := ioutil.WriteFile(configFile, []byte("secret-api-key\n"), 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = slclient.LoadConfigFromFile(configFile, "")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).ToNot(ContainSubstring("secret-api-key"))
+		})
+	})
+
+	Context("#LoadConfig", func() {
+		It("reads the config file named by SL_CONFIG_FILE", func() {
+			os.Setenv("SL_CONFIG_FILE", configFile)
+
+			config, err := slclient.LoadConfig("staging")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ConfigFile).To(Equal(configFile))
+			Expect(config.Username).To(Equal("staging-username"))
+		})
+	})
+
+	Context("#String", func() {
+		It("reports the source of each value without the API key", func() {
+			config, err := slclient.LoadConfigFromFile(configFile, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, description := range []string{config.String(), fmt.Sprintf("%v", config), fmt.Sprintf("%#v", config)} {
+				Expect(description).To(ContainSubstring("username: file-username (from %s [softlayer])", configFile))
+				Expect(description).ToNot(ContainSubstring("file-api-key"))
+			}
+		})
+	})
+
+	Context("#NewSoftLayerClientFromEnvironment", func() {
+		It("creates a client from the environment", func() {
+			os.Setenv("SL_USERNAME", "env-username")
+			os.Setenv("SL_API_KEY", "env-api-key")
+			os.Setenv("SL_CONFIG_FILE", filepath.Join(tmpDir, "fake-config-file"))
+
+			client, err := slclient.NewSoftLayerClientFromEnvironment()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client).ToNot(BeNil())
+		})
+
+		It("fails without credentials", func() {
+			os.Setenv("SL_CONFIG_FILE", filepath.Join(tmpDir, "fake-config-file"))
+
+			_, err := slclient.NewSoftLayerClientFromEnvironment()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package client_fakes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestClientFakes(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Client Fakes Suite")
+}
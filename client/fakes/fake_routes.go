@@ -0,0 +1,199 @@
+package client_fakes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// FakeRequest is a request made through the FakeSoftLayerClient.
+type FakeRequest struct {
+	Verb string
+	Path string
+
+	// Mask is the object mask of the request, e.g. mask[id,hostname], or ""
+	// when there is none.
+	Mask    string
+	Options *softlayer.RequestOptions
+
+	// Body is the decoded JSON body of the request, nil when there is none or
+	// it is not JSON.
+	Body    interface{}
+	RawBody []byte
+}
+
+// Parameters returns the "parameters" of the request body, which services
+// send as a list or, for a single parameter, as an object.
+func (fr FakeRequest) Parameters() []interface{} {
+	body, ok := fr.Body.(map[string]interface{})
+	if !ok {
+		return []interface{}{}
+	}
+
+	switch parameters := body["parameters"].(type) {
+	case []interface{}:
+		return parameters
+	case nil:
+		return []interface{}{}
+	default:
+		return []interface{}{parameters}
+	}
+}
+
+// FakeRoute answers the requests made with its verb on a path matching its
+// pattern, e.g. SoftLayer_Virtual_Guest/*/getObject.json as for path.Match.
+type FakeRoute struct {
+	// Verb is the HTTP method of the requests, any method when "".
+	Verb        string
+	PathPattern string
+
+	// Mask, when set, restricts the route to the requests with this object
+	// mask. The routes with a mask take precedence over the ones without.
+	Mask string
+
+	// Responses are returned to the successive requests, the last one being
+	// repeated, unless Error is set.
+	Responses [][]byte
+	Error     error
+
+	// Calls is the number of requests the route answered.
+	Calls int
+}
+
+// AddRoute adds a route answering the requests matching verb and pathPattern
+// with the responses. The returned route can be refined, e.g. with a Mask.
+func (fslc *FakeSoftLayerClient) AddRoute(verb string, pathPattern string, responses ...[]byte) *FakeRoute {
+	route := &FakeRoute{
+		Verb:        verb,
+		PathPattern: pathPattern,
+		Responses:   responses,
+	}
+
+	fslc.Routes = append(fslc.Routes, route)
+
+	return route
+}
+
+// RequestsMatching returns the requests made with verb, any verb when "", on
+// a path matching pathPattern.
+func (fslc *FakeSoftLayerClient) RequestsMatching(verb string, pathPattern string) []FakeRequest {
+	requests := []FakeRequest{}
+	for _, request := range fslc.Requests {
+		if (verb == "" || verb == request.Verb) && matchesPath(pathPattern, request.Path) {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+//Private methods
+
+func (fslc *FakeSoftLayerClient) route(request FakeRequest) ([]byte, error) {
+	var matchingRoute *FakeRoute
+	for _, route := range fslc.Routes {
+		if !route.matches(request) {
+			continue
+		}
+
+		if route.Mask != "" {
+			matchingRoute = route
+			break
+		}
+
+		if matchingRoute == nil {
+			matchingRoute = route
+		}
+	}
+
+	if matchingRoute == nil {
+		description := request.Verb + " " + request.Path
+		if request.Mask != "" {
+			description += " with " + request.Mask
+		}
+
+		return []byte{}, fmt.Errorf("FakeSoftLayerClient: unexpected request %s", description)
+	}
+
+	return matchingRoute.respond()
+}
+
+func (fr *FakeRoute) matches(request FakeRequest) bool {
+	if fr.Verb != "" && fr.Verb != request.Verb {
+		return false
+	}
+
+	if !matchesPath(fr.PathPattern, request.Path) {
+		return false
+	}
+
+	if fr.Mask == "" {
+		return true
+	}
+
+	return normalizeMask(fr.Mask) == normalizeMask(request.Mask)
+}
+
+func (fr *FakeRoute) respond() ([]byte, error) {
+	fr.Calls += 1
+
+	if fr.Error != nil {
+		return []byte{}, fr.Error
+	}
+
+	if len(fr.Responses) == 0 {
+		return []byte{}, nil
+	}
+
+	index := fr.Calls - 1
+	if index >= len(fr.Responses) {
+		index = len(fr.Responses) - 1
+	}
+
+	return fr.Responses[index], nil
+}
+
+//Private functions
+
+func newFakeRequest(path string, options *softlayer.RequestOptions, requestType string, requestBody *bytes.Buffer) FakeRequest {
+	request := FakeRequest{
+		Verb:    requestType,
+		Path:    path,
+		Options: options,
+		RawBody: []byte{},
+	}
+
+	if options != nil {
+		request.Mask = options.Mask.String()
+	}
+
+	if requestBody != nil {
+		request.RawBody = append(request.RawBody, requestBody.Bytes()...)
+	}
+
+	if len(bytes.TrimSpace(request.RawBody)) > 0 {
+		var body interface{}
+		if err := json.Unmarshal(request.RawBody, &body); err == nil {
+			request.Body = body
+		}
+	}
+
+	return request
+}
+
+func matchesPath(pathPattern string, requestPath string) bool {
+	matched, err := path.Match(pathPattern, requestPath)
+	return err == nil && matched
+}
+
+func normalizeMask(mask string) string {
+	objectMask, err := softlayer.ParseObjectMask(mask)
+	if err != nil {
+		return mask
+	}
+
+	return objectMask.String()
+}
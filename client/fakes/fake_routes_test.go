@@ -0,0 +1,149 @@
+package client_fakes_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("FakeSoftLayerClient routes", func() {
+	var (
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service
+	)
+
+	BeforeEach(func() {
+		fakeClient = slclientfakes.NewFakeSoftLayerClient("fake-username", "fake-api-key")
+
+		var err error
+		virtualGuestService, err = fakeClient.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("#AddRoute", func() {
+		It("answers the requests matching the verb and path pattern", func() {
+			fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/*/getObject.json", []byte(`{"id": 1234567, "hostname": "fake-hostname"}`))
+			fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/*/isPingable.json", []byte(`true`))
+
+			pingable, err := virtualGuestService.IsPingable(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pingable).To(BeTrue())
+
+			virtualGuest, err := virtualGuestService.GetObject(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuest.Hostname).To(Equal("fake-hostname"))
+		})
+
+		It("returns the responses in order and repeats the last one", func() {
+			route := fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/*/isPingable.json", []byte(`false`), []byte(`true`))
+
+			for _, expected := range []bool{false, true, true} {
+				pingable, err := virtualGuestService.IsPingable(1234567)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pingable).To(Equal(expected))
+			}
+
+			Expect(route.Calls).To(Equal(3))
+		})
+
+		It("returns the error of the route", func() {
+			fakeClient.AddRoute("", "SoftLayer_Virtual_Guest/*/isPingable.json").Error = errors.New("fake-error")
+
+			_, err := virtualGuestService.IsPingable(1234567)
+			Expect(err).To(MatchError("fake-error"))
+		})
+
+		It("prefers the routes matching the object mask", func() {
+			fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/*/getObject.json", []byte(`{"id": 1234567}`))
+			fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/*/getObject.json", []byte(`{"id": 1234567, "hostname": "fake-hostname"}`)).Mask = "hostname"
+
+			virtualGuest, err := virtualGuestService.GetObjectWithOptions(context.Background(), 1234567, &softlayer.RequestOptions{Mask: softlayer.NewObjectMask("hostname")})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuest.Hostname).To(Equal("fake-hostname"))
+
+			virtualGuest, err = virtualGuestService.GetObject(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuest.Hostname).To(Equal(""))
+		})
+
+		It("fails the requests without a route", func() {
+			fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/*/getObject.json", []byte(`{"id": 1234567}`))
+
+			_, err := virtualGuestService.IsPingable(1234567)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unexpected request GET SoftLayer_Virtual_Guest/1234567/isPingable.json"))
+
+			_, err = fakeClient.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/getObject.json", "DELETE", new(bytes.Buffer))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#Requests", func() {
+		It("records the verb, path, mask and decoded body of the requests", func() {
+			fakeClient.AddRoute("POST", "SoftLayer_Virtual_Guest/*/editObject.json", []byte(`true`))
+			fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/*/getObject.json", []byte(`{"id": 1234567}`))
+
+			_, err := virtualGuestService.EditObject(1234567, datatypes.SoftLayer_Virtual_Guest{Notes: "fake-notes"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = fakeClient.DoRawHttpRequestWithObjectMask("SoftLayer_Virtual_Guest/1234567/getObject.json", []string{"mask.hostname", "mask.domain"}, "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.Requests).To(HaveLen(2))
+
+			editRequest := fakeClient.Requests[0]
+			Expect(editRequest.Verb).To(Equal("POST"))
+			Expect(editRequest.Path).To(Equal("SoftLayer_Virtual_Guest/1234567/editObject.json"))
+			Expect(editRequest.Mask).To(Equal(""))
+			Expect(editRequest.Parameters()).To(HaveLen(1))
+			Expect(editRequest.Parameters()[0]).To(HaveKey("notes"))
+
+			getRequest := fakeClient.Requests[1]
+			Expect(getRequest.Verb).To(Equal("GET"))
+			Expect(getRequest.Mask).To(Equal("mask[hostname,domain]"))
+			Expect(getRequest.Body).To(BeNil())
+		})
+
+		It("records the requests of the invocations", func() {
+			fakeClient.AddRoute("POST", "SoftLayer_Network_Subnet/1234/editNote.json", []byte(`true`))
+
+			err := fakeClient.Invoke(context.Background(), softlayer.Invocation{
+				Service:    "SoftLayer_Network_Subnet",
+				Id:         1234,
+				Method:     "editNote",
+				Parameters: []interface{}{"fake-note"},
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.Requests).To(HaveLen(1))
+			Expect(fakeClient.Requests[0].Parameters()).To(Equal([]interface{}{"fake-note"}))
+		})
+	})
+
+	Context("#RequestsMatching", func() {
+		It("returns the requests matching the verb and path pattern", func() {
+			fakeClient.AddRoute("", "SoftLayer_Virtual_Guest/*/*", []byte(`true`))
+			fakeClient.AddRoute("DELETE", "SoftLayer_Virtual_Guest/*", []byte(`true`))
+
+			_, err := virtualGuestService.IsPingable(1)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = virtualGuestService.IsPingable(2)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = virtualGuestService.DeleteObject(1)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.RequestsMatching("GET", "SoftLayer_Virtual_Guest/*/isPingable.json")).To(HaveLen(2))
+			Expect(fakeClient.RequestsMatching("DELETE", "SoftLayer_Virtual_Guest/*")).To(HaveLen(1))
+			Expect(fakeClient.RequestsMatching("", "SoftLayer_Virtual_Guest/*/*")).To(HaveLen(2))
+			Expect(fakeClient.RequestsMatching("", "SoftLayer_Account/*")).To(BeEmpty())
+		})
+	})
+})
@@ -2,6 +2,8 @@ package client_fakes
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -31,6 +33,17 @@ type FakeSoftLayerClient struct {
 	DoRawHttpRequestResponsesIndex int
 	DoRawHttpRequestError          error
 
+	DoHttpRequestOptions *softlayer.RequestOptions
+
+	Invocations []softlayer.Invocation
+
+	// Routes, when not empty, answer the requests instead of the
+	// DoRawHttpRequestResponse(s) and any request without a route fails.
+	Routes []*FakeRoute
+
+	// Requests are all the requests made through the client, in order.
+	Requests []FakeRequest
+
 	GenerateRequestBodyBuffer *bytes.Buffer
 	GenerateRequestBodyError  error
 
@@ -169,33 +182,66 @@ func (fslc *FakeSoftLayerClient) GetSoftLayer_Hardware_Service() (softlayer.Soft
 
 //Public methods
 func (fslc *FakeSoftLayerClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
-	fslc.DoRawHttpRequestResponseCount += 1
+	return fslc.DoRawHttpRequestWithObjectMaskWithContext(context.Background(), path, masks, requestType, requestBody)
+}
 
-	if fslc.DoRawHttpRequestError != nil {
-		return []byte{}, fslc.DoRawHttpRequestError
+func (fslc *FakeSoftLayerClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	return fslc.DoRawHttpRequestWithContext(context.Background(), path, requestType, requestBody)
+}
+
+func (fslc *FakeSoftLayerClient) DoRawHttpRequestWithObjectMaskWithContext(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	options := &softlayer.RequestOptions{Mask: softlayer.ParseLegacyObjectMasks(masks)}
+	return fslc.doRawHttpRequest(ctx, newFakeRequest(path, options, requestType, requestBody))
+}
+
+func (fslc *FakeSoftLayerClient) DoRawHttpRequestWithContext(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	return fslc.doRawHttpRequest(ctx, newFakeRequest(path, nil, requestType, requestBody))
+}
+
+func (fslc *FakeSoftLayerClient) DoHttpRequestWithContext(ctx context.Context, path string, options *softlayer.RequestOptions, requestType string, requestBody *bytes.Buffer, result interface{}) error {
+	fslc.DoHttpRequestOptions = options
+
+	response, err := fslc.doRawHttpRequest(ctx, newFakeRequest(path, options, requestType, requestBody))
+	if err != nil {
+		return err
 	}
 
-	if fslc.DoRawHttpRequestResponse != nil {
-		return fslc.DoRawHttpRequestResponse, fslc.DoRawHttpRequestError
-	} else {
-		fslc.DoRawHttpRequestResponsesIndex = fslc.DoRawHttpRequestResponsesIndex + 1
-		return fslc.DoRawHttpRequestResponses[fslc.DoRawHttpRequestResponsesIndex-1], fslc.DoRawHttpRequestError
+	err = json.Unmarshal(response, result)
+	if err != nil {
+		return &softlayer.SoftLayerError{
+			Message: fmt.Sprintf("failed to decode JSON response, err message '%s'", err.Error()),
+			Path:    path,
+			Body:    response,
+			Err:     err,
+		}
 	}
+
+	return nil
 }
 
-func (fslc *FakeSoftLayerClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
-	fslc.DoRawHttpRequestResponseCount += 1
+func (fslc *FakeSoftLayerClient) Invoke(ctx context.Context, invocation softlayer.Invocation, result interface{}) error {
+	fslc.Invocations = append(fslc.Invocations, invocation)
 
-	if fslc.DoRawHttpRequestError != nil {
-		return []byte{}, fslc.DoRawHttpRequestError
+	if err := invocation.Validate(); err != nil {
+		return err
 	}
 
-	if fslc.DoRawHttpRequestResponse != nil {
-		return fslc.DoRawHttpRequestResponse, fslc.DoRawHttpRequestError
-	} else {
-		fslc.DoRawHttpRequestResponsesIndex = fslc.DoRawHttpRequestResponsesIndex + 1
-		return fslc.DoRawHttpRequestResponses[fslc.DoRawHttpRequestResponsesIndex-1], fslc.DoRawHttpRequestError
+	requestType, requestBody := "GET", new(bytes.Buffer)
+	if len(invocation.Parameters) > 0 {
+		requestType = "POST"
+
+		err := json.NewEncoder(requestBody).Encode(map[string]interface{}{"parameters": invocation.Parameters})
+		if err != nil {
+			return err
+		}
+	}
+
+	if result == nil {
+		_, err := fslc.doRawHttpRequest(ctx, newFakeRequest(invocation.Path(), invocation.Options, requestType, requestBody))
+		return err
 	}
+
+	return fslc.DoHttpRequestWithContext(ctx, invocation.Path(), invocation.Options, requestType, requestBody, result)
 }
 
 func (fslc *FakeSoftLayerClient) GenerateRequestBody(templateData interface{}) (*bytes.Buffer, error) {
@@ -224,3 +270,27 @@ func (fslc *FakeSoftLayerClient) initSoftLayerServices() {
 	fslc.SoftLayerServices["SoftLayer_Virtual_Guest_Block_Device_Template_Group"] = services.NewSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service(fslc)
 	fslc.SoftLayerServices["SoftLayer_Hardware"] = services.NewSoftLayer_Hardware_Service(fslc)
 }
+
+func (fslc *FakeSoftLayerClient) doRawHttpRequest(ctx context.Context, request FakeRequest) ([]byte, error) {
+	fslc.DoRawHttpRequestResponseCount += 1
+	fslc.Requests = append(fslc.Requests, request)
+
+	if err := ctx.Err(); err != nil {
+		return []byte{}, err
+	}
+
+	if fslc.DoRawHttpRequestError != nil {
+		return []byte{}, fslc.DoRawHttpRequestError
+	}
+
+	if len(fslc.Routes) > 0 {
+		return fslc.route(request)
+	}
+
+	if fslc.DoRawHttpRequestResponse != nil {
+		return fslc.DoRawHttpRequestResponse, fslc.DoRawHttpRequestError
+	} else {
+		fslc.DoRawHttpRequestResponsesIndex = fslc.DoRawHttpRequestResponsesIndex + 1
+		return fslc.DoRawHttpRequestResponses[fslc.DoRawHttpRequestResponsesIndex-1], fslc.DoRawHttpRequestError
+	}
+}
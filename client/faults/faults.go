@@ -0,0 +1,341 @@
+package faults
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type Kind string
+
+const (
+	// FAULT_LATENCY delays the request by the Latency of the fault. It adds up
+	// with the other faults of the request.
+	FAULT_LATENCY Kind = "latency"
+
+	// FAULT_SERVER_ERROR answers with a 500 and a body that is not JSON, as a
+	// failing load balancer does.
+	FAULT_SERVER_ERROR Kind = "server_error"
+
+	// FAULT_TOO_MANY_REQUESTS answers with a 429 and a rate limit exception.
+	FAULT_TOO_MANY_REQUESTS Kind = "too_many_requests"
+
+	// FAULT_EXCEPTION answers with the SoftLayer exception of the fault.
+	FAULT_EXCEPTION Kind = "exception"
+
+	// FAULT_TRUNCATED_BODY sends the request and cuts the response body in
+	// half.
+	FAULT_TRUNCATED_BODY Kind = "truncated_body"
+
+	// FAULT_DROPPED_CONNECTION fails the request with ErrConnectionDropped
+	// before it is sent.
+	FAULT_DROPPED_CONNECTION Kind = "dropped_connection"
+
+	// FAULT_NULL_BODY and FAULT_EMPTY_BODY send the request and replace the
+	// body of its successful response by null or nothing.
+	FAULT_NULL_BODY  Kind = "null_body"
+	FAULT_EMPTY_BODY Kind = "empty_body"
+)
+
+var ErrConnectionDropped = errors.New("faults: connection dropped")
+
+var xmlRpcMethodName = regexp.MustCompile(`<methodName>([^<]*)</methodName>`)
+
+// Fault describes a failure of the API and the calls it affects.
+type Fault struct {
+	Kind Kind
+
+	// Methods restricts the fault to calls of these SoftLayer methods, given
+	// as "getObject" or "SoftLayer_Virtual_Guest::getObject". The fault
+	// applies to every call when empty.
+	Methods []string
+
+	// Probability, between 0 and 1, is the chance that a matching call fails.
+	// Zero means that every matching call fails.
+	Probability float64
+
+	// Latency is the delay of FAULT_LATENCY.
+	Latency time.Duration
+
+	// StatusCode, 500 when zero, Code and Message make the exception of
+	// FAULT_EXCEPTION.
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+// Injector is an http.RoundTripper, to pass to client.WithTransport, that
+// makes the calls to the API fail as described by its faults. The calls that
+// are not failed go through to the underlying transport.
+type Injector struct {
+	transport http.RoundTripper
+	faults    []Fault
+
+	mutex    sync.Mutex
+	random   *rand.Rand
+	injected map[Kind]int
+}
+
+// New returns an injector of faults in front of transport,
+// http.DefaultTransport when nil.
+func New(transport http.RoundTripper, faults ...Fault) *Injector {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Injector{
+		transport: transport,
+		faults:    faults,
+		random:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		injected:  map[Kind]int{},
+	}
+}
+
+// Seed makes the probabilistic faults happen on the same calls at each run.
+func (i *Injector) Seed(seed int64) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.random = rand.New(rand.NewSource(seed))
+}
+
+// Injected returns the number of times a fault of this kind was injected.
+func (i *Injector) Injected(kind Kind) int {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	return i.injected[kind]
+}
+
+func (i *Injector) RoundTrip(req *http.Request) (*http.Response, error) {
+	service, method, xmlRpc, err := serviceAndMethod(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fault := range i.faults {
+		if !fault.matches(service, method) || !i.happens(fault) {
+			continue
+		}
+
+		if fault.Kind == FAULT_LATENCY {
+			select {
+			case <-time.After(fault.Latency):
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			}
+			continue
+		}
+
+		return i.inject(fault, req, xmlRpc)
+	}
+
+	return i.transport.RoundTrip(req)
+}
+
+//Private methods
+
+func (f Fault) matches(service string, method string) bool {
+	if len(f.Methods) == 0 {
+		return true
+	}
+
+	for _, faultMethod := range f.Methods {
+		if faultMethod == method || faultMethod == service+"::"+method {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (i *Injector) happens(fault Fault) bool {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if fault.Probability > 0 && i.random.Float64() >= fault.Probability {
+		return false
+	}
+
+	i.injected[fault.Kind]++
+
+	return true
+}
+
+func (i *Injector) inject(fault Fault, req *http.Request, xmlRpc bool) (*http.Response, error) {
+	switch fault.Kind {
+	case FAULT_SERVER_ERROR:
+		return newResponse(req, http.StatusInternalServerError, "text/html", "<html><body><h1>500 Internal Server Error</h1></body></html>"), nil
+	case FAULT_TOO_MANY_REQUESTS:
+		return newExceptionResponse(req, xmlRpc, http.StatusTooManyRequests, softlayer.SOFTLAYER_EXCEPTION_RATE_LIMIT_EXCEEDED, "Rate limit exceeded."), nil
+	case FAULT_EXCEPTION:
+		statusCode := fault.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusInternalServerError
+		}
+		return newExceptionResponse(req, xmlRpc, statusCode, fault.Code, fault.Message), nil
+	case FAULT_DROPPED_CONNECTION:
+		return nil, ErrConnectionDropped
+	case FAULT_TRUNCATED_BODY, FAULT_NULL_BODY, FAULT_EMPTY_BODY:
+		return i.replaceBody(fault.Kind, req)
+	}
+
+	return nil, fmt.Errorf("faults: unknown fault '%s'", fault.Kind)
+}
+
+// replaceBody sends the request and alters the body of a successful response.
+func (i *Injector) replaceBody(kind Kind, req *http.Request) (*http.Response, error) {
+	resp, err := i.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var reader io.Reader = resp.Body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+
+		reader = gzipReader
+	}
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusBadRequest {
+		switch kind {
+		case FAULT_TRUNCATED_BODY:
+			body = body[:len(body)/2]
+		case FAULT_NULL_BODY:
+			body = []byte("null")
+		case FAULT_EMPTY_BODY:
+			body = []byte{}
+		}
+	}
+
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	resp.ContentLength = int64(len(body))
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+//Private functions
+
+// serviceAndMethod returns the SoftLayer service and method a request calls,
+// from the path of REST requests and from the body of XML-RPC ones.
+func serviceAndMethod(req *http.Request) (string, string, bool, error) {
+	segments := strings.Split(strings.TrimSuffix(req.URL.Path, ".json"), "/")
+	for len(segments) > 0 && !strings.HasPrefix(segments[0], "SoftLayer_") {
+		segments = segments[1:]
+	}
+
+	if len(segments) == 0 {
+		return "", "", false, nil
+	}
+
+	service := segments[0]
+
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "text/xml") {
+		body, err := readBody(req)
+		if err != nil {
+			return "", "", false, err
+		}
+
+		method := ""
+		if match := xmlRpcMethodName.FindSubmatch(body); match != nil {
+			method = string(match[1])
+		}
+
+		return service, method, true, nil
+	}
+
+	if len(segments) >= 3 {
+		return service, segments[2], false, nil
+	}
+
+	if len(segments) == 2 {
+		if _, err := strconv.Atoi(segments[1]); err != nil {
+			return service, segments[1], false, nil
+		}
+	}
+
+	switch req.Method {
+	case "POST":
+		if len(segments) == 1 {
+			return service, "createObject", false, nil
+		}
+		return service, "editObject", false, nil
+	case "PUT":
+		return service, "editObject", false, nil
+	case "DELETE":
+		return service, "deleteObject", false, nil
+	}
+
+	return service, "getObject", false, nil
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return []byte{}, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+func newExceptionResponse(req *http.Request, xmlRpc bool, statusCode int, code string, message string) *http.Response {
+	if xmlRpc {
+		return newResponse(req, statusCode, "text/xml", `<?xml version="1.0" encoding="iso-8859-1"?><methodResponse><fault><value><struct>`+
+			`<member><name>faultCode</name><value><string>`+html.EscapeString(code)+`</string></value></member>`+
+			`<member><name>faultString</name><value><string>`+html.EscapeString(message)+`</string></value></member>`+
+			`</struct></value></fault></methodResponse>`)
+	}
+
+	body, _ := json.Marshal(struct {
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{message, code})
+
+	return newResponse(req, statusCode, "application/json", string(body))
+}
+
+func newResponse(req *http.Request, statusCode int, contentType string, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {contentType}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,264 @@
+package faults_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	faults "github.com/maximilien/softlayer-go/client/faults"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("Injector", func() {
+	var (
+		server   *httptest.Server
+		requests int32
+	)
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&requests, 0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.Write([]byte(`{"id": 1234567, "hostname": "fake-hostname"}`))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newClient := func(injector *faults.Injector, options ...slclient.ClientOption) softlayer.Client {
+		options = append([]slclient.ClientOption{
+			slclient.WithEndpoint(server.URL),
+			slclient.WithTransport(injector),
+			slclient.WithRetryPolicy(slclient.RetryPolicy{MaxAttempts: 1}),
+		}, options...)
+
+		return slclient.NewSoftLayerClient("fake-username", "fake-api-key", options...)
+	}
+
+	getObject := func(client softlayer.Client) (map[string]interface{}, error) {
+		result := map[string]interface{}{}
+		err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Virtual_Guest/1234567/getObject.json", nil, "GET", nil, &result)
+
+		return result, err
+	}
+
+	It("sends the requests without faults", func() {
+		result, err := getObject(newClient(faults.New(nil)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result["hostname"]).To(Equal("fake-hostname"))
+		Expect(atomic.LoadInt32(&requests)).To(Equal(int32(1)))
+	})
+
+	Context("FAULT_LATENCY", func() {
+		It("delays the request", func() {
+			injector := faults.New(nil, faults.Fault{Kind: faults.FAULT_LATENCY, Latency: 50 * time.Millisecond})
+
+			start := time.Now()
+			_, err := getObject(newClient(injector))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+			Expect(injector.Injected(faults.FAULT_LATENCY)).To(Equal(1))
+		})
+
+		It("stops waiting when the request context is done", func() {
+			injector := faults.New(nil, faults.Fault{Kind: faults.FAULT_LATENCY, Latency: time.Minute})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			err := newClient(injector).DoHttpRequestWithContext(ctx, "SoftLayer_Account/getVirtualGuests.json", nil, "GET", nil, &[]interface{}{})
+			Expect(err).To(HaveOccurred())
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(0)))
+		})
+	})
+
+	Context("FAULT_SERVER_ERROR", func() {
+		It("answers with a 500 without sending the request", func() {
+			_, err := getObject(newClient(faults.New(nil, faults.Fault{Kind: faults.FAULT_SERVER_ERROR})))
+			Expect(err).To(HaveOccurred())
+
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slErr.StatusCode).To(Equal(500))
+			Expect(slErr.Code).To(Equal(""))
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(0)))
+		})
+	})
+
+	Context("FAULT_TOO_MANY_REQUESTS", func() {
+		It("answers with a rate limit exception", func() {
+			_, err := getObject(newClient(faults.New(nil, faults.Fault{Kind: faults.FAULT_TOO_MANY_REQUESTS})))
+			Expect(softlayer.IsRateLimited(err)).To(BeTrue())
+		})
+
+		It("is retried by the retry policy", func() {
+			injector := faults.New(nil, faults.Fault{Kind: faults.FAULT_TOO_MANY_REQUESTS})
+
+			retryPolicy := slclient.DefaultRetryPolicy()
+			retryPolicy.BaseBackoff = time.Millisecond
+
+			_, err := getObject(newClient(injector, slclient.WithRetryPolicy(retryPolicy)))
+			Expect(err).To(HaveOccurred())
+			Expect(injector.Injected(faults.FAULT_TOO_MANY_REQUESTS)).To(Equal(slclient.DEFAULT_RETRY_MAX_ATTEMPTS))
+		})
+	})
+
+	Context("FAULT_EXCEPTION", func() {
+		It("answers with the exception", func() {
+			injector := faults.New(nil, faults.Fault{
+				Kind:       faults.FAULT_EXCEPTION,
+				StatusCode: 404,
+				Code:       softlayer.SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND,
+				Message:    "Unable to find object with id of '1234567'.",
+			})
+
+			_, err := getObject(newClient(injector))
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+
+			slErr, _ := softlayer.AsSoftLayerError(err)
+			Expect(slErr.Message).To(Equal("Unable to find object with id of '1234567'."))
+		})
+
+		It("answers with an XML-RPC fault to XML-RPC requests", func() {
+			injector := faults.New(nil, faults.Fault{
+				Kind:    faults.FAULT_EXCEPTION,
+				Code:    "SoftLayer_Exception_Public",
+				Message: "Fake <exception>",
+			})
+
+			_, err := getObject(newClient(injector, slclient.WithXmlRpc(server.URL)))
+
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slErr.Code).To(Equal("SoftLayer_Exception_Public"))
+			Expect(slErr.Message).To(Equal("Fake <exception>"))
+		})
+	})
+
+	Context("FAULT_TRUNCATED_BODY", func() {
+		It("cuts the response body", func() {
+			_, err := getObject(newClient(faults.New(nil, faults.Fault{Kind: faults.FAULT_TRUNCATED_BODY})))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to decode JSON response"))
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(1)))
+		})
+	})
+
+	Context("FAULT_DROPPED_CONNECTION", func() {
+		It("fails the request without sending it", func() {
+			_, err := getObject(newClient(faults.New(nil, faults.Fault{Kind: faults.FAULT_DROPPED_CONNECTION})))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(faults.ErrConnectionDropped.Error()))
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(0)))
+		})
+	})
+
+	Context("FAULT_NULL_BODY and FAULT_EMPTY_BODY", func() {
+		It("replaces the response body by null", func() {
+			client := newClient(faults.New(nil, faults.Fault{Kind: faults.FAULT_NULL_BODY}))
+
+			response, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/getObject.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(response)).To(Equal("null"))
+		})
+
+		It("replaces the response body by nothing", func() {
+			client := newClient(faults.New(nil, faults.Fault{Kind: faults.FAULT_EMPTY_BODY}))
+
+			response, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/getObject.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeEmpty())
+		})
+	})
+
+	Context("Methods", func() {
+		var injector *faults.Injector
+
+		BeforeEach(func() {
+			injector = faults.New(nil, faults.Fault{
+				Kind:    faults.FAULT_SERVER_ERROR,
+				Methods: []string{"SoftLayer_Virtual_Guest::getObject", "getVirtualGuests"},
+			})
+		})
+
+		It("fails the calls of the methods", func() {
+			client := newClient(injector)
+
+			_, err := getObject(client)
+			Expect(err).To(HaveOccurred())
+
+			err = client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Account/getVirtualGuests.json", nil, "GET", nil, &[]interface{}{})
+			Expect(err).To(HaveOccurred())
+
+			Expect(injector.Injected(faults.FAULT_SERVER_ERROR)).To(Equal(2))
+		})
+
+		It("does not fail the calls of other methods", func() {
+			client := newClient(injector)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/getPowerState.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = client.DoRawHttpRequest("SoftLayer_Hardware/1234567/getObject.json", "GET", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(injector.Injected(faults.FAULT_SERVER_ERROR)).To(Equal(0))
+		})
+
+		It("matches the methods of XML-RPC calls", func() {
+			client := newClient(injector, slclient.WithXmlRpc(server.URL))
+
+			_, err := getObject(client)
+			Expect(err).To(HaveOccurred())
+			Expect(injector.Injected(faults.FAULT_SERVER_ERROR)).To(Equal(1))
+		})
+	})
+
+	Context("Probability", func() {
+		It("fails a share of the calls", func() {
+			injector := faults.New(nil, faults.Fault{Kind: faults.FAULT_DROPPED_CONNECTION, Probability: 0.5})
+			injector.Seed(42)
+
+			client := newClient(injector)
+
+			failures := 0
+			for i := 0; i < 200; i++ {
+				if _, err := getObject(client); err != nil {
+					failures++
+				}
+			}
+
+			Expect(failures).To(Equal(injector.Injected(faults.FAULT_DROPPED_CONNECTION)))
+			Expect(failures).To(BeNumerically("~", 100, 30))
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(200 - failures)))
+		})
+
+		It("fails the same calls with the same seed", func() {
+			outcomes := func() string {
+				injector := faults.New(nil, faults.Fault{Kind: faults.FAULT_SERVER_ERROR, Probability: 0.3})
+				injector.Seed(7)
+
+				client := newClient(injector)
+
+				results := []string{}
+				for i := 0; i < 20; i++ {
+					_, err := getObject(client)
+					results = append(results, map[bool]string{true: "x", false: "."}[err != nil])
+				}
+
+				return strings.Join(results, "")
+			}
+
+			Expect(outcomes()).To(Equal(outcomes()))
+		})
+	})
+})
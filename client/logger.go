@@ -0,0 +1,103 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+type LogLevel int
+
+const (
+	LOG_LEVEL_DEBUG LogLevel = iota
+	LOG_LEVEL_INFO
+	LOG_LEVEL_WARN
+	LOG_LEVEL_ERROR
+)
+
+const REDACTED = "[REDACTED]"
+
+// LogFields are the structured values attached to a log entry. The client
+// sets service, method, path, verb, status, duration, bytes and attempt, and
+// also request_body and response_body when bodies are logged.
+type LogFields map[string]interface{}
+
+// Logger receives an entry for each request sent by the client, see
+// WithLogger. Implementations may be called from several goroutines.
+type Logger interface {
+	Log(level LogLevel, message string, fields LogFields)
+}
+
+func (l LogLevel) String() string {
+	switch l {
+	case LOG_LEVEL_DEBUG:
+		return "DEBUG"
+	case LOG_LEVEL_INFO:
+		return "INFO"
+	case LOG_LEVEL_WARN:
+		return "WARN"
+	case LOG_LEVEL_ERROR:
+		return "ERROR"
+	}
+
+	return fmt.Sprintf("LogLevel(%d)", int(l))
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Log(level LogLevel, message string, fields LogFields) {}
+
+type writerLogger struct {
+	writer   io.Writer
+	minLevel LogLevel
+
+	mutex sync.Mutex
+}
+
+// NewWriterLogger returns a Logger writing the entries at or above minLevel
+// to writer, one line each, e.g. NewWriterLogger(os.Stderr, LOG_LEVEL_DEBUG).
+func NewWriterLogger(writer io.Writer, minLevel LogLevel) Logger {
+	return &writerLogger{
+		writer:   writer,
+		minLevel: minLevel,
+	}
+}
+
+func (wl *writerLogger) Log(level LogLevel, message string, fields LogFields) {
+	if level < wl.minLevel {
+		return
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	line := fmt.Sprintf("[softlayer-go] %s %s", level, message)
+	for _, key := range keys {
+		line += fmt.Sprintf(" %s=%v", key, fields[key])
+	}
+
+	wl.mutex.Lock()
+	defer wl.mutex.Unlock()
+
+	fmt.Fprintln(wl.writer, line)
+}
+
+//Private helper methods
+
+func redactHeader(header http.Header) http.Header {
+	redacted := http.Header{}
+	for key, values := range header {
+		if http.CanonicalHeaderKey(key) == "Authorization" {
+			redacted[key] = []string{REDACTED}
+			continue
+		}
+		redacted[key] = values
+	}
+
+	return redacted
+}
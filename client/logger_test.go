@@ -0,0 +1,145 @@
+package client_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+type logEntry struct {
+	level   slclient.LogLevel
+	message string
+	fields  slclient.LogFields
+}
+
+type recordingLogger struct {
+	mutex   sync.Mutex
+	entries []logEntry
+}
+
+func (rl *recordingLogger) Log(level slclient.LogLevel, message string, fields slclient.LogFields) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	rl.entries = append(rl.entries, logEntry{level: level, message: message, fields: fields})
+}
+
+var _ = Describe("Logger", func() {
+	var (
+		server  *httptest.Server
+		handler http.HandlerFunc
+		logger  *recordingLogger
+	)
+
+	BeforeEach(func() {
+		logger = &recordingLogger{}
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"id": 1234567}`))
+		}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("#WithLogger", func() {
+		It("logs each request with its fields", func() {
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithLogger(logger),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/getObject.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(logger.entries).To(HaveLen(1))
+			entry := logger.entries[0]
+			Expect(entry.level).To(Equal(slclient.LOG_LEVEL_INFO))
+			Expect(entry.fields["service"]).To(Equal("SoftLayer_Virtual_Guest"))
+			Expect(entry.fields["method"]).To(Equal("getObject"))
+			Expect(entry.fields["path"]).To(Equal("SoftLayer_Virtual_Guest/1234567/getObject.json"))
+			Expect(entry.fields["verb"]).To(Equal("GET"))
+			Expect(entry.fields["status"]).To(Equal(http.StatusOK))
+			Expect(entry.fields["bytes"]).To(Equal(len(`{"id": 1234567}`)))
+			Expect(entry.fields["duration"]).To(BeAssignableToTypeOf(time.Duration(0)))
+
+			Expect(entry.fields).ToNot(HaveKey("request_body"))
+			Expect(entry.fields).ToNot(HaveKey("response_body"))
+		})
+
+		It("logs failed requests as errors", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error": "Unable to find object", "code": "SoftLayer_Exception_ObjectNotFound"}`))
+			}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithLogger(logger),
+			)
+
+			_, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/getObject.json", "GET", new(bytes.Buffer))
+			Expect(err).To(HaveOccurred())
+
+			Expect(logger.entries).To(HaveLen(2))
+			Expect(logger.entries[0].level).To(Equal(slclient.LOG_LEVEL_WARN))
+			Expect(logger.entries[0].fields["status"]).To(Equal(http.StatusNotFound))
+			Expect(logger.entries[1].level).To(Equal(slclient.LOG_LEVEL_ERROR))
+			Expect(logger.entries[1].fields["attempts"]).To(Equal(1))
+			Expect(logger.entries[1].fields["error"]).To(Equal("Unable to find object"))
+		})
+	})
+
+	Context("#WithLogBodies", func() {
+		It("logs the bodies with passwords and the API key redacted", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id": 1234567, "operatingSystem": {"passwords": [{"username": "root", "password": "fake-password"}]}, "notes": "fake-api-key"}`))
+			}
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+				slclient.WithEndpoint(server.URL),
+				slclient.WithLogger(logger),
+				slclient.WithLogBodies(true),
+			)
+
+			requestBody := bytes.NewBufferString(`{"parameters": [{"hostname": "fake-hostname", "apiKey": "fake-api-key"}]}`)
+			_, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/editObject.json", "POST", requestBody)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(logger.entries).To(HaveLen(1))
+			fields := logger.entries[0].fields
+
+			Expect(fields["request_body"]).To(ContainSubstring(`"hostname":"fake-hostname"`))
+			Expect(fields["request_body"]).ToNot(ContainSubstring("fake-api-key"))
+
+			Expect(fields["response_body"]).To(ContainSubstring(`"username":"root"`))
+			Expect(fields["response_body"]).To(ContainSubstring(`"password":"[REDACTED]"`))
+			Expect(fields["response_body"]).ToNot(ContainSubstring("fake-password"))
+			Expect(fields["response_body"]).ToNot(ContainSubstring("fake-api-key"))
+
+			Expect(fields["request_headers"]).To(HaveKey("User-Agent"))
+			Expect(fields["request_headers"].(http.Header).Get("Authorization")).To(Equal("[REDACTED]"))
+		})
+	})
+
+	Context("#NewWriterLogger", func() {
+		It("writes the entries at or above the level on one line each", func() {
+			buffer := new(bytes.Buffer)
+			writerLogger := slclient.NewWriterLogger(buffer, slclient.LOG_LEVEL_INFO)
+
+			writerLogger.Log(slclient.LOG_LEVEL_DEBUG, "fake-debug-message", slclient.LogFields{})
+			writerLogger.Log(slclient.LOG_LEVEL_INFO, "softlayer-go request", slclient.LogFields{"status": 200, "method": "getObject"})
+
+			Expect(buffer.String()).To(Equal("[softlayer-go] INFO softlayer-go request method=getObject status=200\n"))
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket shared by every request, and every goroutine,
+// going through a client. The bucket holds up to burst tokens and refills at
+// requestsPerSecond; each request takes one token or waits until it can.
+type RateLimiter struct {
+	requestsPerSecond float64
+	burst             int
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		requestsPerSecond: requestsPerSecond,
+		burst:             burst,
+
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent, or ctx is done, and returns how
+// long it waited.
+func (rl *RateLimiter) Wait(ctx context.Context) (time.Duration, error) {
+	if rl.requestsPerSecond <= 0 {
+		return 0, nil
+	}
+
+	wait := rl.reserve()
+	if wait <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		rl.cancel()
+		return 0, ctx.Err()
+	case <-timer.C:
+		return wait, nil
+	}
+}
+
+func (rl *RateLimiter) reserve() time.Duration {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	now := time.Now()
+	rl.tokens += now.Sub(rl.last).Seconds() * rl.requestsPerSecond
+	if rl.tokens > float64(rl.burst) {
+		rl.tokens = float64(rl.burst)
+	}
+	rl.last = now
+
+	rl.tokens--
+	if rl.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-rl.tokens / rl.requestsPerSecond * float64(time.Second))
+}
+
+func (rl *RateLimiter) cancel() {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	rl.tokens++
+}
@@ -0,0 +1,81 @@
+package client_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+)
+
+var _ = Describe("RateLimiter", func() {
+	var rateLimiter *slclient.RateLimiter
+
+	BeforeEach(func() {
+		rateLimiter = slclient.NewRateLimiter(20, 2)
+	})
+
+	Context("#Wait", func() {
+		It("lets a burst of requests through without waiting", func() {
+			for i := 0; i < 2; i++ {
+				wait, err := rateLimiter.Wait(context.Background())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(wait).To(Equal(time.Duration(0)))
+			}
+		})
+
+		It("makes requests beyond the burst wait for a token", func() {
+			for i := 0; i < 2; i++ {
+				rateLimiter.Wait(context.Background())
+			}
+
+			wait, err := rateLimiter.Wait(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(wait).To(BeNumerically("~", 50*time.Millisecond, 10*time.Millisecond))
+		})
+
+		It("is shared by all goroutines", func() {
+			var wg sync.WaitGroup
+
+			start := time.Now()
+			for i := 0; i < 6; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					_, err := rateLimiter.Wait(context.Background())
+					Expect(err).ToNot(HaveOccurred())
+				}()
+			}
+			wg.Wait()
+
+			Expect(time.Since(start)).To(BeNumerically(">=", 190*time.Millisecond))
+		})
+
+		It("stops waiting when the context is done", func() {
+			for i := 0; i < 2; i++ {
+				rateLimiter.Wait(context.Background())
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := rateLimiter.Wait(ctx)
+			Expect(err).To(Equal(context.Canceled))
+		})
+
+		It("never waits without a rate", func() {
+			rateLimiter = slclient.NewRateLimiter(0, 0)
+
+			for i := 0; i < 10; i++ {
+				wait, err := rateLimiter.Wait(context.Background())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(wait).To(Equal(time.Duration(0)))
+			}
+		})
+	})
+})
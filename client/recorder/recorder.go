@@ -0,0 +1,284 @@
+package recorder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	redaction "github.com/maximilien/softlayer-go/client/redaction"
+)
+
+type Mode string
+
+const (
+	// MODE_RECORD sends the requests to the API and saves them, with their
+	// responses, in the cassette when the recorder is stopped.
+	MODE_RECORD Mode = "record"
+
+	// MODE_REPLAY answers the requests from the cassette, without network.
+	MODE_REPLAY Mode = "replay"
+
+	SCRUBBED = "[SCRUBBED]"
+)
+
+// Cassette holds the interactions recorded for a test suite.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the part of a request the interactions are matched on.
+type Request struct {
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	ObjectMask   string `json:"objectMask,omitempty"`
+	ObjectFilter string `json:"objectFilter,omitempty"`
+	ResultLimit  string `json:"resultLimit,omitempty"`
+	Body         string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body"`
+}
+
+// Recorder is an http.RoundTripper, to pass to client.WithTransport, that
+// records the API calls into a cassette file or replays them from it.
+//
+// The credentials are never saved: the request headers are left out, the
+// secrets (e.g. the username and API key) are replaced by SCRUBBED wherever
+// they appear, and so are the password and key fields of the bodies. The
+// requests are scrubbed the same way before being matched on replay.
+type Recorder struct {
+	cassettePath string
+	mode         Mode
+	transport    http.RoundTripper
+	secrets      []string
+
+	mutex    sync.Mutex
+	cassette Cassette
+	replayed []bool
+}
+
+// New returns a recorder for the cassette at cassettePath. In MODE_RECORD the
+// requests go through transport, http.DefaultTransport when nil; in
+// MODE_REPLAY the cassette must exist.
+func New(cassettePath string, mode Mode, transport http.RoundTripper, secrets ...string) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	recorder := &Recorder{
+		cassettePath: cassettePath,
+		mode:         mode,
+		transport:    transport,
+	}
+
+	for _, secret := range secrets {
+		if secret != "" {
+			recorder.secrets = append(recorder.secrets, secret)
+		}
+	}
+
+	switch mode {
+	case MODE_RECORD:
+	case MODE_REPLAY:
+		data, err := ioutil.ReadFile(cassettePath)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(data, &recorder.cassette)
+		if err != nil {
+			return nil, fmt.Errorf("recorder: invalid cassette %s: %s", cassettePath, err)
+		}
+
+		recorder.replayed = make([]bool, len(recorder.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("recorder: unknown mode '%s'", mode)
+	}
+
+	return recorder, nil
+}
+
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recordedRequest, err := r.newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == MODE_REPLAY {
+		return r.replay(req, recordedRequest)
+	}
+
+	return r.record(req, recordedRequest)
+}
+
+// Stop saves the cassette when recording.
+func (r *Recorder) Stop() error {
+	if r.mode != MODE_RECORD {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(r.cassettePath), 0755)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.cassettePath, append(data, '\n'), 0644)
+}
+
+//Private methods
+
+func (r *Recorder) newRequest(req *http.Request) (Request, error) {
+	body := []byte{}
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return Request{}, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	query := req.URL.Query()
+
+	return Request{
+		Method:       req.Method,
+		Path:         r.scrub(req.URL.Path),
+		ObjectMask:   query.Get("objectMask"),
+		ObjectFilter: query.Get("objectFilter"),
+		ResultLimit:  query.Get("resultLimit"),
+		Body:         r.scrubBody(body),
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request, recordedRequest Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var reader io.Reader = resp.Body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+
+		reader = gzipReader
+	}
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	recordedResponse := Response{
+		StatusCode: resp.StatusCode,
+		Headers:    map[string]string{},
+		Body:       r.scrubBody(body),
+	}
+	for _, header := range []string{"Content-Type", "SoftLayer-Total-Items"} {
+		if value := resp.Header.Get(header); value != "" {
+			recordedResponse.Headers[header] = value
+		}
+	}
+
+	r.mutex.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{Request: recordedRequest, Response: recordedResponse})
+	r.mutex.Unlock()
+
+	return newResponse(req, Response{StatusCode: resp.StatusCode, Headers: recordedResponse.Headers, Body: string(body)}), nil
+}
+
+// replay answers with the first interaction matching the request that was not
+// replayed yet, or with the last matching one when they all were, e.g. while
+// polling a state that did not change anymore.
+func (r *Recorder) replay(req *http.Request, recordedRequest Request) (*http.Response, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	match := -1
+	for i, interaction := range r.cassette.Interactions {
+		if !interaction.Request.matches(recordedRequest) {
+			continue
+		}
+
+		match = i
+		if !r.replayed[i] {
+			break
+		}
+	}
+
+	if match == -1 {
+		return nil, fmt.Errorf("recorder: no interaction recorded in %s for %s %s", r.cassettePath, recordedRequest.Method, recordedRequest.Path)
+	}
+
+	r.replayed[match] = true
+
+	return newResponse(req, r.cassette.Interactions[match].Response), nil
+}
+
+func (r *Recorder) scrub(text string) string {
+	return redaction.Text(text, SCRUBBED, r.secrets...)
+}
+
+// scrubBody scrubs a JSON or XML-RPC body. The JSON bodies are also
+// normalized so that they match whatever their formatting.
+func (r *Recorder) scrubBody(body []byte) string {
+	return redaction.Body(body, SCRUBBED, r.secrets...)
+}
+
+func (recorded Request) matches(request Request) bool {
+	return recorded == request
+}
+
+//Private functions
+
+func newResponse(req *http.Request, recordedResponse Response) *http.Response {
+	header := http.Header{}
+	for name, value := range recordedResponse.Headers {
+		header.Set(name, value)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recordedResponse.StatusCode, http.StatusText(recordedResponse.StatusCode)),
+		StatusCode:    recordedResponse.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(recordedResponse.Body)),
+		ContentLength: int64(len(recordedResponse.Body)),
+		Request:       req,
+	}
+}
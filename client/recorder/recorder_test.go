@@ -0,0 +1,261 @@
+package recorder_test
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	recorder "github.com/maximilien/softlayer-go/client/recorder"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		server  *httptest.Server
+		handler http.HandlerFunc
+
+		tmpDir       string
+		cassettePath string
+	)
+
+	BeforeEach(func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"id": 1234567, "hostname": "fake-hostname"}`))
+		}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+		}))
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "recorder")
+		Expect(err).ToNot(HaveOccurred())
+
+		cassettePath = filepath.Join(tmpDir, "cassettes", "fake-cassette.json")
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(tmpDir)
+	})
+
+	newClient := func(slRecorder *recorder.Recorder) softlayer.Client {
+		return slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL), slclient.WithTransport(slRecorder), slclient.WithRetryPolicy(slclient.RetryPolicy{MaxAttempts: 1}))
+	}
+
+	record := func(calls func(client softlayer.Client)) {
+		slRecorder, err := recorder.New(cassettePath, recorder.MODE_RECORD, nil, "fake-username", "fake-api-key")
+		Expect(err).ToNot(HaveOccurred())
+
+		calls(newClient(slRecorder))
+
+		err = slRecorder.Stop()
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	replay := func() softlayer.Client {
+		slRecorder, err := recorder.New(cassettePath, recorder.MODE_REPLAY, nil, "fake-username", "fake-api-key")
+		Expect(err).ToNot(HaveOccurred())
+
+		return newClient(slRecorder)
+	}
+
+	Context("#New", func() {
+		It("fails to replay a missing cassette", func() {
+			_, err := recorder.New(cassettePath, recorder.MODE_REPLAY, nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails with an unknown mode", func() {
+			_, err := recorder.New(cassettePath, recorder.Mode("fake-mode"), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("recorder: unknown mode 'fake-mode'"))
+		})
+	})
+
+	Context("when recording", func() {
+		It("saves the interactions in the cassette", func() {
+			record(func(client softlayer.Client) {
+				virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+				Expect(err).ToNot(HaveOccurred())
+
+				virtualGuest, err := virtualGuestService.GetObject(1234567)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(virtualGuest.Hostname).To(Equal("fake-hostname"))
+			})
+
+			data, err := ioutil.ReadFile(cassettePath)
+			Expect(err).ToNot(HaveOccurred())
+
+			cassette := recorder.Cassette{}
+			err = json.Unmarshal(data, &cassette)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cassette.Interactions).To(HaveLen(1))
+			Expect(cassette.Interactions[0].Request.Method).To(Equal("GET"))
+			Expect(cassette.Interactions[0].Request.Path).To(Equal("/SoftLayer_Virtual_Guest/1234567/getObject.json"))
+			Expect(cassette.Interactions[0].Request.ObjectMask).To(ContainSubstring("operatingSystem"))
+			Expect(cassette.Interactions[0].Response.StatusCode).To(Equal(200))
+			Expect(cassette.Interactions[0].Response.Body).To(MatchJSON(`{"id": 1234567, "hostname": "fake-hostname"}`))
+		})
+
+		It("scrubs the credentials and passwords", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id": 1234567, "username": "fake-username", "operatingSystem": {"passwords": [{"username": "root", "password": "fake-password"}]}}`))
+			}
+
+			record(func(client softlayer.Client) {
+				virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+				Expect(err).ToNot(HaveOccurred())
+
+				virtualGuest, err := virtualGuestService.GetObject(1234567)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(virtualGuest.OperatingSystem.Passwords[0].Password).To(Equal("fake-password"))
+			})
+
+			data, err := ioutil.ReadFile(cassettePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).ToNot(ContainSubstring("fake-username"))
+			Expect(string(data)).ToNot(ContainSubstring("fake-api-key"))
+			Expect(string(data)).ToNot(ContainSubstring("fake-password"))
+			Expect(string(data)).To(ContainSubstring(recorder.SCRUBBED))
+		})
+
+		It("saves the gzip responses decompressed", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Encoding", "gzip")
+				gzipWriter := gzip.NewWriter(w)
+				gzipWriter.Write([]byte(`{"id": 1234567, "hostname": "fake-hostname"}`))
+				gzipWriter.Close()
+			}
+
+			record(func(client softlayer.Client) {
+				virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+				Expect(err).ToNot(HaveOccurred())
+
+				virtualGuest, err := virtualGuestService.GetObject(1234567)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(virtualGuest.Hostname).To(Equal("fake-hostname"))
+			})
+
+			server.Close()
+
+			virtualGuestService, err := replay().GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			virtualGuest, err := virtualGuestService.GetObject(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuest.Hostname).To(Equal("fake-hostname"))
+		})
+	})
+
+	Context("when replaying", func() {
+		It("answers from the cassette without the API", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`true`))
+			}
+
+			record(func(client softlayer.Client) {
+				virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = virtualGuestService.SetTags(1234567, []string{"prod", "web"})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			server.Close()
+
+			virtualGuestService, err := replay().GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = virtualGuestService.SetTags(1234567, []string{"prod", "web"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("matches the requests on their body", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`true`))
+			}
+
+			record(func(client softlayer.Client) {
+				virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = virtualGuestService.SetTags(1234567, []string{"prod"})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			virtualGuestService, err := replay().GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = virtualGuestService.SetTags(1234567, []string{"dev"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("recorder: no interaction recorded"))
+		})
+
+		It("matches the requests on their object mask", func() {
+			record(func(client softlayer.Client) {
+				invocation := softlayer.Invocation{
+					Service: "SoftLayer_Virtual_Guest",
+					Id:      1234567,
+					Method:  "getObject",
+					Options: &softlayer.RequestOptions{Mask: softlayer.NewObjectMask("id", "hostname")},
+				}
+
+				err := client.Invoke(context.Background(), invocation, nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			client := replay()
+
+			invocation := softlayer.Invocation{
+				Service: "SoftLayer_Virtual_Guest",
+				Id:      1234567,
+				Method:  "getObject",
+				Options: &softlayer.RequestOptions{Mask: softlayer.NewObjectMask("id", "hostname")},
+			}
+			err := client.Invoke(context.Background(), invocation, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			invocation.Options = &softlayer.RequestOptions{Mask: softlayer.NewObjectMask("id")}
+			err = client.Invoke(context.Background(), invocation, nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("replays the same requests in order and then repeats the last one", func() {
+			powerStates := []string{"HALTED", "RUNNING"}
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"keyName": "` + powerStates[0] + `"}`))
+				powerStates = powerStates[1:]
+			}
+
+			record(func(client softlayer.Client) {
+				virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+				Expect(err).ToNot(HaveOccurred())
+
+				for i := 0; i < 2; i++ {
+					_, err = virtualGuestService.GetPowerState(1234567)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+
+			virtualGuestService, err := replay().GetSoftLayer_Virtual_Guest_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, keyName := range []string{"HALTED", "RUNNING", "RUNNING"} {
+				powerState, err := virtualGuestService.GetPowerState(1234567)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(powerState.KeyName).To(Equal(keyName))
+			}
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package redaction
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// secretKeys are the JSON keys and XML-RPC member names, lower-cased, whose
+// values are secrets.
+var secretKeys = map[string]bool{
+	"password":          true,
+	"apikey":            true,
+	"api_key":           true,
+	"authenticationkey": true,
+	"privatekey":        true,
+}
+
+var xmlRpcSecretMembers = regexp.MustCompile(`(?i)(<name>(password|apikey|api_key|authenticationkey|privatekey)</name><value>).*?(</value>)`)
+
+// Body replaces by replacement the secret fields of a JSON body, e.g.
+// operatingSystem.passwords.password, or the secret members of an XML-RPC
+// body, and any occurrence of the secrets. The JSON bodies are re-encoded, so
+// that they read the same whatever their formatting.
+func Body(body []byte, replacement string, secrets ...string) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err == nil {
+		if encoded, err := json.Marshal(redactJson(decoded, replacement)); err == nil {
+			return Text(string(encoded), replacement, secrets...)
+		}
+	}
+
+	return Text(xmlRpcSecretMembers.ReplaceAllString(string(body), "${1}"+replacement+"${3}"), replacement, secrets...)
+}
+
+// Text replaces by replacement any occurrence of the secrets in text.
+func Text(text string, replacement string, secrets ...string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			text = strings.Replace(text, secret, replacement, -1)
+		}
+	}
+
+	return text
+}
+
+//Private functions
+
+func redactJson(value interface{}, replacement string) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range typedValue {
+			if secretKeys[strings.ToLower(key)] {
+				typedValue[key] = replacement
+			} else {
+				typedValue[key] = redactJson(fieldValue, replacement)
+			}
+		}
+	case []interface{}:
+		for i, element := range typedValue {
+			typedValue[i] = redactJson(element, replacement)
+		}
+	}
+
+	return value
+}
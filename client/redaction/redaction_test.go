@@ -0,0 +1,45 @@
+package redaction_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	redaction "github.com/maximilien/softlayer-go/client/redaction"
+)
+
+var _ = Describe("Redaction", func() {
+	Context("#Body", func() {
+		It("replaces the secret fields of a JSON body at any depth", func() {
+			body := []byte(`{"parameters":[{"hostname":"fake-hostname","operatingSystem":{"passwords":[{"username":"root","password":"fake-password"}]},"apiKey":"fake-api-key"}]}`)
+
+			redacted := redaction.Body(body, "[HIDDEN]")
+			Expect(redacted).To(Equal(`{"parameters":[{"apiKey":"[HIDDEN]","hostname":"fake-hostname","operatingSystem":{"passwords":[{"password":"[HIDDEN]","username":"root"}]}}]}`))
+		})
+
+		It("keeps the numbers of a JSON body as they are", func() {
+			Expect(redaction.Body([]byte(`{"id": 12345678901234567890}`), "[HIDDEN]")).To(Equal(`{"id":12345678901234567890}`))
+		})
+
+		It("replaces the secret members of an XML-RPC body", func() {
+			body := []byte(`<member><name>username</name><value><string>fake-username</string></value></member><member><name>apiKey</name><value><string>fake-api-key</string></value></member>`)
+
+			redacted := redaction.Body(body, "[HIDDEN]")
+			Expect(redacted).To(ContainSubstring("<name>username</name><value><string>fake-username</string></value>"))
+			Expect(redacted).To(ContainSubstring("<name>apiKey</name><value>[HIDDEN]</value>"))
+		})
+
+		It("replaces the secrets anywhere in the body", func() {
+			Expect(redaction.Body([]byte(`{"notes":"key fake-api-key"}`), "[HIDDEN]", "fake-api-key")).To(Equal(`{"notes":"key [HIDDEN]"}`))
+		})
+
+		It("is empty for an empty body", func() {
+			Expect(redaction.Body([]byte(" \n"), "[HIDDEN]", "fake-api-key")).To(Equal(""))
+		})
+	})
+
+	Context("#Text", func() {
+		It("replaces the secrets, ignoring the empty ones", func() {
+			Expect(redaction.Text("fake-username:fake-api-key", "[HIDDEN]", "", "fake-api-key")).To(Equal("fake-username:[HIDDEN]"))
+		})
+	})
+})
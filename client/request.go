@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// request is a single SoftLayer API call, independent of the wire format
+// used to send it.
+type request struct {
+	service string
+	method  string
+
+	path        string
+	options     softlayer.RequestOptions
+	requestType string
+	body        []byte
+
+	// totalItems receives the SoftLayer-Total-Items header of the response.
+	totalItems *int
+}
+
+func newRequest(path string, options *softlayer.RequestOptions, requestType string, requestBody *bytes.Buffer) request {
+	service, method := serviceAndMethodFromPath(path, requestType)
+
+	r := request{
+		service: service,
+		method:  method,
+
+		path:        path,
+		requestType: requestType,
+	}
+
+	if options != nil {
+		r.options = *options
+		r.totalItems = &options.TotalItems
+	}
+
+	if requestBody != nil {
+		r.body = requestBody.Bytes()
+	}
+
+	return r
+}
+
+// queryString returns the query parameters of the request, already escaped.
+func (r request) queryString() string {
+	parameters := []string{}
+
+	if !r.options.Mask.IsEmpty() {
+		parameters = append(parameters, "objectMask="+r.options.Mask.Encode())
+	}
+
+	if !r.options.Filter.IsEmpty() {
+		parameters = append(parameters, "objectFilter="+r.options.Filter.Encode())
+	}
+
+	if r.options.ResultLimit != nil {
+		parameters = append(parameters, "resultLimit="+r.options.ResultLimit.String())
+	}
+
+	return strings.Join(parameters, "&")
+}
+
+// initParameter returns the id of the object the request is made on, taken
+// from its path, or 0 when there is none.
+func (r request) initParameter() int {
+	segments := strings.Split(strings.TrimSuffix(r.path, ".json"), "/")
+	if len(segments) < 2 {
+		return 0
+	}
+
+	id, err := strconv.Atoi(segments[1])
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
+
+// pathParameters returns the positional parameters the REST API takes after
+// the method in the path, e.g. 25 for
+// SoftLayer_Virtual_Guest/1234567/checkHostDiskAvailability/25, with the
+// numbers as json.Number.
+func (r request) pathParameters() []interface{} {
+	parameters := []interface{}{}
+
+	segments := strings.Split(strings.TrimSuffix(r.path, ".json"), "/")
+	if len(segments) <= 3 {
+		return parameters
+	}
+
+	for _, segment := range segments[3:] {
+		if _, err := strconv.Atoi(segment); err == nil {
+			parameters = append(parameters, json.Number(segment))
+		} else {
+			parameters = append(parameters, segment)
+		}
+	}
+
+	return parameters
+}
+
+// parameters returns the positional parameters of the request, the ones of
+// its path followed by the ones sent in its JSON body, with the numbers kept
+// as json.Number.
+func (r request) parameters() ([]interface{}, error) {
+	pathParameters := r.pathParameters()
+	if len(bytes.TrimSpace(r.body)) == 0 {
+		return pathParameters, nil
+	}
+
+	body := struct {
+		Parameters []interface{} `json:"parameters"`
+	}{}
+
+	decoder := json.NewDecoder(bytes.NewReader(r.body))
+	decoder.UseNumber()
+	err := decoder.Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(pathParameters, body.Parameters...), nil
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.reader.Read(p)
+	cr.count += n
+
+	return n, err
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+type requestStatsKey struct{}
+
+// RequestStats collects what the client did to serve the calls made with a
+// context returned by WithRequestStats. A service method may send several
+// requests, so the values accumulate. It is not safe to share a RequestStats
+// between concurrent calls.
+type RequestStats struct {
+	Requests int
+	Attempts int
+
+	// RateLimitWait is the total time spent waiting on the client rate limiter.
+	RateLimitWait time.Duration
+}
+
+func WithRequestStats(ctx context.Context, stats *RequestStats) context.Context {
+	return context.WithValue(ctx, requestStatsKey{}, stats)
+}
+
+func requestStatsFromContext(ctx context.Context) *RequestStats {
+	stats, ok := ctx.Value(requestStatsKey{}).(*RequestStats)
+	if !ok {
+		return &RequestStats{}
+	}
+
+	return stats
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// transport sends a single attempt of a request over one of the wire
+// protocols of the API. The client handles the rate limiting, retries and
+// error logging around it, so that services do not know which one is used.
+type transport interface {
+	do(ctx context.Context, r request, result interface{}, attempt int) ([]byte, error)
+}
+
+// restTransport sends the requests to the REST API, with the credentials in
+// an Authorization header and the options in the query string.
+type restTransport struct {
+	client *softLayerClient
+}
+
+func (t *restTransport) do(ctx context.Context, r request, result interface{}, attempt int) ([]byte, error) {
+	slc := t.client
+
+	fields := LogFields{
+		"service": r.service,
+		"method":  r.method,
+		"path":    r.path,
+		"verb":    r.requestType,
+		"attempt": attempt,
+	}
+
+	req, err := http.NewRequest(r.requestType, t.requestUrl(r), bytes.NewReader(r.body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.SetBasicAuth(slc.username, slc.apiKey)
+	req.Header.Set("User-Agent", slc.userAgent)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	if slc.logBodies {
+		fields["request_headers"] = redactHeader(req.Header)
+		fields["request_body"] = slc.redact(string(r.body))
+	}
+
+	start := time.Now()
+	resp, err := slc.httpClient.Do(req)
+	if err != nil {
+		fields["duration"] = time.Since(start)
+		fields["error"] = slc.redact(err.Error())
+		slc.logger.Log(LOG_LEVEL_WARN, "softlayer-go request", fields)
+
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	body := &countingReader{reader: resp.Body}
+	var reader io.Reader = body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+
+		reader = gzipReader
+	}
+
+	var responseBody []byte
+	if result == nil || slc.logBodies || resp.StatusCode >= http.StatusBadRequest {
+		responseBody, err = ioutil.ReadAll(reader)
+		reader = bytes.NewReader(responseBody)
+	}
+
+	if err == nil && result != nil && resp.StatusCode < http.StatusBadRequest {
+		if decodeErr := json.NewDecoder(reader).Decode(result); decodeErr != nil {
+			err = newInvalidJsonResponseError(r, resp.StatusCode, responseBody, decodeErr)
+		}
+	}
+
+	fields["duration"] = time.Since(start)
+	fields["status"] = resp.StatusCode
+	fields["bytes"] = body.count
+	if slc.logBodies {
+		fields["response_body"] = slc.redact(string(responseBody))
+	}
+
+	if err != nil {
+		fields["error"] = slc.redact(err.Error())
+		slc.logger.Log(LOG_LEVEL_WARN, "softlayer-go request", fields)
+
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		slc.logger.Log(LOG_LEVEL_WARN, "softlayer-go request", fields)
+
+		return responseBody, newSoftLayerError(r.path, r.requestType, resp.StatusCode, responseBody)
+	}
+
+	slc.logger.Log(LOG_LEVEL_INFO, "softlayer-go request", fields)
+
+	if totalItems, err := strconv.Atoi(resp.Header.Get("SoftLayer-Total-Items")); err == nil && r.totalItems != nil {
+		*r.totalItems = totalItems
+	}
+
+	if result != nil {
+		return nil, nil
+	}
+
+	return responseBody, nil
+}
+
+func (t *restTransport) requestUrl(r request) string {
+	url := t.client.endpoint + "/" + r.path
+	if queryString := r.queryString(); queryString != "" {
+		url += "?" + queryString
+	}
+
+	return url
+}
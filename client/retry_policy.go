@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const (
+	DEFAULT_RETRY_MAX_ATTEMPTS = 3
+	DEFAULT_RETRY_BASE_BACKOFF = 1 * time.Second
+	DEFAULT_RETRY_MAX_BACKOFF  = 30 * time.Second
+	DEFAULT_RETRY_JITTER       = 0.2
+)
+
+// RetryPolicy controls how the client retries requests that failed with a
+// transient error. A policy with MaxAttempts of 1 or less disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+
+	// The delay before retry n is BaseBackoff * 2^(n-1), capped at MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each delay that is
+	// randomized so that concurrent clients do not retry in lockstep.
+	Jitter float64
+
+	// RetryableStatusCodes and RetryableExceptionCodes select the API errors
+	// that are retried. Transport errors (e.g. connection resets) are always
+	// retried unless the request context is done.
+	RetryableStatusCodes    []int
+	RetryableExceptionCodes []string
+
+	// IdempotentMethods are the SoftLayer methods retried when they are sent
+	// as POST, PUT or DELETE requests. Other such requests are never retried
+	// since repeating them could, for instance, place the same order twice.
+	// GET requests are always retried.
+	IdempotentMethods []string
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DEFAULT_RETRY_MAX_ATTEMPTS,
+		BaseBackoff: DEFAULT_RETRY_BASE_BACKOFF,
+		MaxBackoff:  DEFAULT_RETRY_MAX_BACKOFF,
+		Jitter:      DEFAULT_RETRY_JITTER,
+
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableExceptionCodes: []string{
+			softlayer.SOFTLAYER_EXCEPTION_RATE_LIMIT_EXCEEDED,
+		},
+
+		IdempotentMethods: []string{
+			"getSubnetForIpAddress",
+			"getByIpAddress",
+			"findByIpAddress",
+		},
+	}
+}
+
+// ShouldRetry reports whether a call to the SoftLayer method, sent as a
+// requestType (e.g. GET) request, that failed with err may be attempted again.
+func (rp RetryPolicy) ShouldRetry(requestType string, method string, err error) bool {
+	if err == nil || !rp.isIdempotent(requestType, method) {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	slErr, ok := softlayer.AsSoftLayerError(err)
+	if !ok || (slErr.StatusCode == 0 && slErr.Code == "") {
+		return true
+	}
+
+	for _, statusCode := range rp.RetryableStatusCodes {
+		if statusCode == slErr.StatusCode {
+			return true
+		}
+	}
+
+	for _, code := range rp.RetryableExceptionCodes {
+		if code == slErr.Code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Backoff returns the delay to wait after the given (1-based) failed attempt.
+func (rp RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	backoff := float64(rp.BaseBackoff) * math.Pow(2, float64(attempt-1))
+	if rp.MaxBackoff > 0 && backoff > float64(rp.MaxBackoff) {
+		backoff = float64(rp.MaxBackoff)
+	}
+
+	if rp.Jitter > 0 {
+		backoff -= backoff * math.Min(rp.Jitter, 1) * rand.Float64()
+	}
+
+	return time.Duration(backoff)
+}
+
+//Private methods
+
+func (rp RetryPolicy) isIdempotent(requestType string, method string) bool {
+	if requestType == "GET" {
+		return true
+	}
+
+	for _, idempotentMethod := range rp.IdempotentMethods {
+		if idempotentMethod == method {
+			return true
+		}
+	}
+
+	return false
+}
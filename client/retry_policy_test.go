@@ -0,0 +1,106 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("RetryPolicy", func() {
+	var retryPolicy slclient.RetryPolicy
+
+	BeforeEach(func() {
+		retryPolicy = slclient.DefaultRetryPolicy()
+	})
+
+	Context("#ShouldRetry", func() {
+		It("retries rate limited requests", func() {
+			err := &softlayer.SoftLayerError{StatusCode: 429}
+			Expect(retryPolicy.ShouldRetry("GET", "getObject", err)).To(BeTrue())
+
+			err = &softlayer.SoftLayerError{StatusCode: 500, Code: softlayer.SOFTLAYER_EXCEPTION_RATE_LIMIT_EXCEEDED}
+			Expect(retryPolicy.ShouldRetry("GET", "getObject", err)).To(BeTrue())
+		})
+
+		It("retries unavailable gateways", func() {
+			err := &softlayer.SoftLayerError{StatusCode: 503}
+			Expect(retryPolicy.ShouldRetry("GET", "getObject", err)).To(BeTrue())
+		})
+
+		It("retries transport errors", func() {
+			err := errors.New("read: connection reset by peer")
+			Expect(retryPolicy.ShouldRetry("GET", "getObject", err)).To(BeTrue())
+		})
+
+		It("does not retry client errors", func() {
+			err := &softlayer.SoftLayerError{StatusCode: 404, Code: softlayer.SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND}
+			Expect(retryPolicy.ShouldRetry("GET", "getObject", err)).To(BeFalse())
+		})
+
+		It("does not retry the methods sent as POST, PUT or DELETE requests", func() {
+			err := &softlayer.SoftLayerError{StatusCode: 503}
+			Expect(retryPolicy.ShouldRetry("POST", "placeOrder", err)).To(BeFalse())
+			Expect(retryPolicy.ShouldRetry("POST", "createObject", err)).To(BeFalse())
+			Expect(retryPolicy.ShouldRetry("POST", "setTags", err)).To(BeFalse())
+			Expect(retryPolicy.ShouldRetry("PUT", "editObject", err)).To(BeFalse())
+			Expect(retryPolicy.ShouldRetry("DELETE", "deleteObject", err)).To(BeFalse())
+		})
+
+		It("does not retry a transport error configuring the metadata disk", func() {
+			err := &softlayer.SoftLayerError{StatusCode: 0, Message: "read: connection reset by peer"}
+			Expect(retryPolicy.ShouldRetry("POST", "configureMetadataDisk", err)).To(BeFalse())
+		})
+
+		It("retries the idempotent methods sent as POST requests", func() {
+			err := &softlayer.SoftLayerError{StatusCode: 503}
+			Expect(retryPolicy.ShouldRetry("POST", "getSubnetForIpAddress", err)).To(BeTrue())
+		})
+
+		It("retries the methods added to the idempotent methods of the policy", func() {
+			retryPolicy.IdempotentMethods = append(retryPolicy.IdempotentMethods, "setTags")
+
+			err := &softlayer.SoftLayerError{StatusCode: 503}
+			Expect(retryPolicy.ShouldRetry("POST", "setTags", err)).To(BeTrue())
+		})
+
+		It("does not retry cancelled requests", func() {
+			err := fmt.Errorf("Get: %w", context.Canceled)
+			Expect(retryPolicy.ShouldRetry("GET", "getObject", err)).To(BeFalse())
+		})
+	})
+
+	Context("#Backoff", func() {
+		BeforeEach(func() {
+			retryPolicy.BaseBackoff = 100 * time.Millisecond
+			retryPolicy.MaxBackoff = 1 * time.Second
+			retryPolicy.Jitter = 0
+		})
+
+		It("doubles the delay after each attempt", func() {
+			Expect(retryPolicy.Backoff(1)).To(Equal(100 * time.Millisecond))
+			Expect(retryPolicy.Backoff(2)).To(Equal(200 * time.Millisecond))
+			Expect(retryPolicy.Backoff(3)).To(Equal(400 * time.Millisecond))
+		})
+
+		It("caps the delay at MaxBackoff", func() {
+			Expect(retryPolicy.Backoff(10)).To(Equal(1 * time.Second))
+		})
+
+		It("randomizes up to Jitter of the delay", func() {
+			retryPolicy.Jitter = 0.5
+
+			for i := 0; i < 20; i++ {
+				backoff := retryPolicy.Backoff(2)
+				Expect(backoff).To(BeNumerically(">=", 100*time.Millisecond))
+				Expect(backoff).To(BeNumerically("<=", 200*time.Millisecond))
+			}
+		})
+	})
+})
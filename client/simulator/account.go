@@ -0,0 +1,210 @@
+package simulator
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+//Private methods
+
+func (s *Simulator) getAccountStatus(c call) (interface{}, error) {
+	return datatypes.SoftLayer_Account_Status{Id: 1001, Name: "Active"}, nil
+}
+
+func (s *Simulator) getAccountVirtualGuests(c call) (interface{}, error) {
+	virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
+	for _, id := range sortedIds(s.virtualGuests) {
+		if guest := s.virtualGuests[id]; !guest.deleted {
+			virtualGuests = append(virtualGuests, guest.guest)
+		}
+	}
+
+	return virtualGuests, nil
+}
+
+func (s *Simulator) getAccountSshKeys(c call) (interface{}, error) {
+	sshKeys := []datatypes.SoftLayer_Security_Ssh_Key{}
+	for _, id := range sortedIds(s.sshKeys) {
+		sshKeys = append(sshKeys, *s.sshKeys[id])
+	}
+
+	return sshKeys, nil
+}
+
+func (s *Simulator) getAccountNetworkStorage(c call) (interface{}, error) {
+	networkStorage := []datatypes.SoftLayer_Network_Storage{}
+	for _, id := range sortedIds(s.networkStorage) {
+		networkStorage = append(networkStorage, s.networkStorage[id].storage)
+	}
+
+	return networkStorage, nil
+}
+
+func (s *Simulator) getAccountIscsiNetworkStorage(c call) (interface{}, error) {
+	networkStorage := []datatypes.SoftLayer_Network_Storage{}
+	for _, id := range sortedIds(s.networkStorage) {
+		if storage := s.networkStorage[id].storage; storage.NasType == "ISCSI" {
+			networkStorage = append(networkStorage, storage)
+		}
+	}
+
+	return networkStorage, nil
+}
+
+func (s *Simulator) getAccountVirtualDiskImages(c call) (interface{}, error) {
+	return []datatypes.SoftLayer_Virtual_Disk_Image{}, nil
+}
+
+func (s *Simulator) getAccountBlockDeviceTemplateGroups(c call) (interface{}, error) {
+	return []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, nil
+}
+
+func (s *Simulator) getAccountHardware(c call) (interface{}, error) {
+	return []datatypes.SoftLayer_Hardware{}, nil
+}
+
+func (s *Simulator) findSshKey(id int) (*datatypes.SoftLayer_Security_Ssh_Key, error) {
+	sshKey, ok := s.sshKeys[id]
+	if !ok {
+		return nil, newObjectNotFoundError(id)
+	}
+
+	return sshKey, nil
+}
+
+func (s *Simulator) createSshKey(c call) (interface{}, error) {
+	template := datatypes.SoftLayer_Security_Ssh_Key{}
+	err := c.parameter(0, &template)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.Key == "" {
+		return nil, newPublicError("Property 'key' must be set to create an instance of 'SoftLayer_Security_Ssh_Key'.")
+	}
+
+	for _, sshKey := range s.sshKeys {
+		if sshKey.Key == template.Key {
+			return nil, newPublicError("SSH key already exists with label '%s'.", sshKey.Label)
+		}
+	}
+
+	now := time.Now()
+	sshKey := &datatypes.SoftLayer_Security_Ssh_Key{
+		CreateDate:  &now,
+		Fingerprint: fingerprint(template.Key),
+		Id:          s.newId(),
+		Key:         template.Key,
+		Label:       template.Label,
+		Notes:       template.Notes,
+	}
+
+	s.sshKeys[sshKey.Id] = sshKey
+
+	return *sshKey, nil
+}
+
+func (s *Simulator) getSshKey(c call) (interface{}, error) {
+	sshKey, err := s.findSshKey(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return *sshKey, nil
+}
+
+func (s *Simulator) editSshKey(c call) (interface{}, error) {
+	sshKey, err := s.findSshKey(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	template := datatypes.SoftLayer_Security_Ssh_Key{}
+	err = c.parameter(0, &template)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.Label != "" {
+		sshKey.Label = template.Label
+	}
+	if template.Notes != "" {
+		sshKey.Notes = template.Notes
+	}
+
+	now := time.Now()
+	sshKey.ModifyDate = &now
+
+	return true, nil
+}
+
+func (s *Simulator) deleteSshKey(c call) (interface{}, error) {
+	_, err := s.findSshKey(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.sshKeys, c.id)
+
+	return true, nil
+}
+
+func (s *Simulator) getSshKeySoftwarePasswords(c call) (interface{}, error) {
+	_, err := s.findSshKey(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return []datatypes.SoftLayer_Software_Component_Password{}, nil
+}
+
+//Private functions
+
+// fingerprint returns the MD5 fingerprint of an OpenSSH public key, or of the
+// whole key when it is not in the OpenSSH format.
+func fingerprint(key string) string {
+	data := []byte(key)
+	if fields := strings.Fields(key); len(fields) >= 2 {
+		if decoded, err := base64.StdEncoding.DecodeString(fields[1]); err == nil {
+			data = decoded
+		}
+	}
+
+	sum := md5.Sum(data)
+
+	hexBytes := make([]string, len(sum))
+	for i, b := range sum {
+		hexBytes[i] = fmt.Sprintf("%02x", b)
+	}
+
+	return strings.Join(hexBytes, ":")
+}
+
+func sortedIds(objects interface{}) []int {
+	ids := []int{}
+
+	switch typedObjects := objects.(type) {
+	case map[int]*virtualGuest:
+		for id := range typedObjects {
+			ids = append(ids, id)
+		}
+	case map[int]*datatypes.SoftLayer_Security_Ssh_Key:
+		for id := range typedObjects {
+			ids = append(ids, id)
+		}
+	case map[int]*networkStorage:
+		for id := range typedObjects {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Ints(ids)
+
+	return ids
+}
@@ -0,0 +1,268 @@
+package simulator
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const (
+	ISCSI_PACKAGE_ID = 0
+
+	UPGRADE_ORDER_COMPLEX_TYPE = "SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade"
+)
+
+type networkStorage struct {
+	storage datatypes.SoftLayer_Network_Storage
+
+	// cancelDate is when the billing item of an immediately cancelled
+	// volume goes away.
+	cancelDate time.Time
+}
+
+func (n *networkStorage) advance(now time.Time) {
+	if !n.cancelDate.IsZero() && !now.Before(n.cancelDate) {
+		n.storage.BillingItem = nil
+		n.cancelDate = time.Time{}
+	}
+}
+
+//Private methods
+
+func (s *Simulator) getPackageItemPrices(c call) (interface{}, error) {
+	if c.id != ISCSI_PACKAGE_ID {
+		return nil, newObjectNotFoundError(c.id)
+	}
+
+	return iscsiItemPrices(), nil
+}
+
+func (s *Simulator) verifyOrder(c call) (interface{}, error) {
+	order := datatypes.SoftLayer_Product_Order{}
+	err := c.parameter(0, &order)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.orderedPrices(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *Simulator) placeOrder(c call) (interface{}, error) {
+	order := datatypes.SoftLayer_Product_Order{}
+	err := c.parameter(0, &order)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := s.orderedPrices(order)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId := s.newId()
+
+	if order.ComplexType == UPGRADE_ORDER_COMPLEX_TYPE {
+		for _, orderedGuest := range order.VirtualGuests {
+			guest := s.virtualGuests[orderedGuest.Id]
+			guest.queue(s, "Cloud Upgrade", []string{"CLOUD_UPGRADE_SETUP", "CLOUD_UPGRADE_COMPLETE"}, nil, func() {
+				upgradeVirtualGuest(guest, prices)
+			})
+		}
+	} else {
+		for _, price := range prices {
+			capacity, _ := strconv.Atoi(price.Item.Capacity)
+
+			id := s.newId()
+			s.networkStorage[id] = &networkStorage{
+				storage: datatypes.SoftLayer_Network_Storage{
+					AccountId:                       s.config.AccountId,
+					CapacityGb:                      capacity,
+					CreateDate:                      time.Now(),
+					Id:                              id,
+					NasType:                         "ISCSI",
+					Password:                        fmt.Sprintf("password-%d", id),
+					Username:                        fmt.Sprintf("SL01SU%d-1", s.config.AccountId),
+					ServiceResourceBackendIpAddress: "10.1.1.1",
+					BillingItem: &datatypes.Billing_Item{
+						Id: s.newId(),
+						OrderItem: &datatypes.Order_Item{
+							Order: &datatypes.Order{Id: orderId},
+						},
+					},
+				},
+			}
+		}
+	}
+
+	return datatypes.SoftLayer_Product_Order_Receipt{OrderId: orderId}, nil
+}
+
+// orderedPrices returns the prices of order, which must be upgrade prices for
+// the upgrade of existing virtual guests, or iSCSI volume prices otherwise.
+func (s *Simulator) orderedPrices(order datatypes.SoftLayer_Product_Order) ([]datatypes.SoftLayer_Item_Price, error) {
+	if len(order.Prices) == 0 {
+		return nil, newPublicError("No prices were specified for the order.")
+	}
+
+	availablePrices := iscsiItemPrices()
+	if order.ComplexType == UPGRADE_ORDER_COMPLEX_TYPE {
+		if len(order.VirtualGuests) == 0 {
+			return nil, newPublicError("No virtual guests were specified for the upgrade.")
+		}
+
+		for _, orderedGuest := range order.VirtualGuests {
+			if guest, ok := s.virtualGuests[orderedGuest.Id]; !ok || guest.deleted {
+				return nil, newObjectNotFoundError(orderedGuest.Id)
+			}
+		}
+
+		availablePrices = upgradeItemPrices()
+	} else if order.PackageId != ISCSI_PACKAGE_ID {
+		return nil, newObjectNotFoundError(order.PackageId)
+	}
+
+	prices := []datatypes.SoftLayer_Item_Price{}
+	for _, orderedPrice := range order.Prices {
+		found := false
+		for _, price := range availablePrices {
+			if price.Id == orderedPrice.Id {
+				prices = append(prices, price)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, newPublicError("Price # %d is not valid for this order.", orderedPrice.Id)
+		}
+	}
+
+	return prices, nil
+}
+
+func (s *Simulator) getNetworkStorage(c call) (interface{}, error) {
+	storage, ok := s.networkStorage[c.id]
+	if !ok {
+		return nil, newObjectNotFoundError(c.id)
+	}
+
+	return storage.storage, nil
+}
+
+// createCancellationRequest cancels the billing items of network storage. The
+// immediate cancellations complete after the transaction duration, the other
+// ones at the anniversary date, which never comes in the simulator.
+func (s *Simulator) createCancellationRequest(c call) (interface{}, error) {
+	request := datatypes.SoftLayer_Billing_Item_Cancellation_Request{}
+	err := c.parameter(0, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(request.Items) == 0 {
+		return nil, newPublicError("No billing items were specified for cancellation.")
+	}
+
+	cancelled := []*networkStorage{}
+	for _, item := range request.Items {
+		var storage *networkStorage
+		for _, candidate := range s.networkStorage {
+			if candidate.storage.BillingItem != nil && candidate.storage.BillingItem.Id == item.BillingItemId {
+				storage = candidate
+				break
+			}
+		}
+
+		if storage == nil {
+			return nil, newObjectNotFoundError(item.BillingItemId)
+		}
+
+		if item.ImmediateCancellationFlag {
+			cancelled = append(cancelled, storage)
+		}
+	}
+
+	for _, storage := range cancelled {
+		storage.cancelDate = time.Now().Add(s.config.TransactionDuration)
+	}
+
+	request.Id = s.newId()
+	request.AccountId = s.config.AccountId
+
+	return request, nil
+}
+
+//Private functions
+
+func iscsiItemPrices() []datatypes.SoftLayer_Item_Price {
+	itemPrices := []datatypes.SoftLayer_Item_Price{}
+	for i, capacity := range []int{20, 40, 80, 100, 250, 500, 1000, 2000} {
+		itemPrices = append(itemPrices, datatypes.SoftLayer_Item_Price{
+			Id: 2000 + i,
+			Item: &datatypes.Item{
+				Id:          3000 + i,
+				Description: fmt.Sprintf("%d GB iSCSI SAN Storage", capacity),
+				Capacity:    strconv.Itoa(capacity),
+			},
+		})
+	}
+
+	return itemPrices
+}
+
+// upgradeItemPrices returns the prices of the cores, memory, port speed and
+// disks a virtual guest can be upgraded to.
+func upgradeItemPrices() []datatypes.SoftLayer_Item_Price {
+	itemPrices := []datatypes.SoftLayer_Item_Price{}
+
+	addPrices := func(categoryCode string, description string, capacities ...int) {
+		for _, capacity := range capacities {
+			id := 4000 + len(itemPrices)
+			itemPrices = append(itemPrices, datatypes.SoftLayer_Item_Price{
+				Id:         id,
+				Categories: []datatypes.Category{{CategoryCode: categoryCode}},
+				Item: &datatypes.Item{
+					Id:          id + 1000,
+					Description: fmt.Sprintf(description, capacity),
+					Capacity:    strconv.Itoa(capacity),
+				},
+			})
+		}
+	}
+
+	addPrices("guest_core", "%d x 2.0 GHz Cores", 1, 2, 4, 8, 16)
+	addPrices("ram", "%d GB", 1, 2, 4, 8, 16, 32, 64)
+	addPrices("port_speed", "%d Mbps Public & Private Network Uplinks", 10, 100, 1000)
+	addPrices("guest_disk1", "%d GB (LOCAL)", 25, 100)
+	for disk := 2; disk <= 5; disk++ {
+		addPrices(fmt.Sprintf("guest_disk%d", disk), "%d GB (SAN)", 10, 25, 100, 250, 500, 1000, 2000)
+	}
+
+	return itemPrices
+}
+
+func upgradeVirtualGuest(guest *virtualGuest, prices []datatypes.SoftLayer_Item_Price) {
+	for _, price := range prices {
+		capacity, _ := strconv.Atoi(price.Item.Capacity)
+
+		for _, category := range price.Categories {
+			switch category.CategoryCode {
+			case "guest_core":
+				guest.guest.StartCpus = capacity
+				guest.guest.MaxCpu = capacity
+			case "ram":
+				guest.guest.MaxMemory = capacity * 1024
+			}
+		}
+	}
+
+	now := time.Now()
+	guest.guest.ModifyDate = &now
+}
@@ -0,0 +1,383 @@
+package simulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const (
+	DEFAULT_ACCOUNT_ID           = 278444
+	DEFAULT_TRANSACTION_DURATION = 100 * time.Millisecond
+)
+
+// Config sets up a Simulator. Credentials are only checked when Username is
+// set.
+type Config struct {
+	Username string
+	ApiKey   string
+
+	AccountId int
+
+	// TransactionDuration is how long each step of a transaction, e.g. the
+	// ones provisioning a virtual guest, stays active.
+	TransactionDuration time.Duration
+}
+
+// Simulator is an in-process stand-in for the SoftLayer REST API, serving the
+// calls this library makes. It keeps the state of the account: virtual guests
+// go through their power states as their transactions complete over time, and
+// SSH keys, tags, iSCSI volumes ordered from the product package and billing
+// cancellations are kept.
+//
+// Object masks and object filters are ignored, result limits are applied.
+type Simulator struct {
+	config Config
+
+	mutex sync.Mutex
+
+	nextId int
+
+	virtualGuests  map[int]*virtualGuest
+	sshKeys        map[int]*datatypes.SoftLayer_Security_Ssh_Key
+	networkStorage map[int]*networkStorage
+}
+
+// Server is a Simulator served over HTTP, to pass to client.WithEndpoint:
+//
+//	server := simulator.NewServer(simulator.Config{})
+//	defer server.Close()
+//
+//	client := slclient.NewSoftLayerClient("username", "apiKey", slclient.WithEndpoint(server.URL))
+type Server struct {
+	*Simulator
+	*httptest.Server
+}
+
+func New(config Config) *Simulator {
+	if config.AccountId == 0 {
+		config.AccountId = DEFAULT_ACCOUNT_ID
+	}
+
+	if config.TransactionDuration <= 0 {
+		config.TransactionDuration = DEFAULT_TRANSACTION_DURATION
+	}
+
+	return &Simulator{
+		config: config,
+		nextId: 1000000,
+
+		virtualGuests:  map[int]*virtualGuest{},
+		sshKeys:        map[int]*datatypes.SoftLayer_Security_Ssh_Key{},
+		networkStorage: map[int]*networkStorage{},
+	}
+}
+
+func NewServer(config Config) *Server {
+	simulator := New(config)
+
+	return &Server{
+		Simulator: simulator,
+		Server:    httptest.NewServer(simulator),
+	}
+}
+
+func (s *Simulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.config.Username != "" {
+		username, apiKey, ok := r.BasicAuth()
+		if !ok || username != s.config.Username || apiKey != s.config.ApiKey {
+			writeError(w, &apiError{
+				statusCode: http.StatusUnauthorized,
+				code:       softlayer.SOFTLAYER_EXCEPTION_INVALID_CREDENTIALS,
+				message:    "Invalid API token.",
+			})
+			return
+		}
+	}
+
+	c, err := newCall(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	handler, ok := handlers[c.service+"/"+c.method]
+	if !ok {
+		writeError(w, newPublicError("Function (\"%s\") is not a valid method for this service.", c.method))
+		return
+	}
+
+	s.mutex.Lock()
+	s.advance(time.Now())
+	result, err := handler(s, c)
+	s.mutex.Unlock()
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, c, result)
+}
+
+// call is a request to the simulator, parsed from paths such as
+// SoftLayer_Virtual_Guest/1234567/getPowerState.json, SoftLayer_Virtual_Guest.json
+// or SoftLayer_Virtual_Guest/1234567/checkHostDiskAvailability/1024.
+type call struct {
+	service    string
+	id         int
+	method     string
+	arguments  []string
+	parameters []json.RawMessage
+
+	resultLimit *softlayer.ResultLimit
+}
+
+type handler func(s *Simulator, c call) (interface{}, error)
+
+// handlers are keyed by service and method.
+var handlers = map[string]handler{
+	"SoftLayer_Account/getAccountStatus":             (*Simulator).getAccountStatus,
+	"SoftLayer_Account/getVirtualGuests":             (*Simulator).getAccountVirtualGuests,
+	"SoftLayer_Account/getSshKeys":                   (*Simulator).getAccountSshKeys,
+	"SoftLayer_Account/getNetworkStorage":            (*Simulator).getAccountNetworkStorage,
+	"SoftLayer_Account/getIscsiNetworkStorage":       (*Simulator).getAccountIscsiNetworkStorage,
+	"SoftLayer_Account/getVirtualDiskImages":         (*Simulator).getAccountVirtualDiskImages,
+	"SoftLayer_Account/getBlockDeviceTemplateGroups": (*Simulator).getAccountBlockDeviceTemplateGroups,
+	"SoftLayer_Account/getHardware":                  (*Simulator).getAccountHardware,
+
+	"SoftLayer_Virtual_Guest/createObject":              (*Simulator).createVirtualGuest,
+	"SoftLayer_Virtual_Guest/getObject":                 (*Simulator).getVirtualGuest,
+	"SoftLayer_Virtual_Guest/editObject":                (*Simulator).editVirtualGuest,
+	"SoftLayer_Virtual_Guest/deleteObject":              (*Simulator).deleteVirtualGuest,
+	"SoftLayer_Virtual_Guest/getPowerState":             (*Simulator).getVirtualGuestPowerState,
+	"SoftLayer_Virtual_Guest/getPrimaryIpAddress":       (*Simulator).getVirtualGuestPrimaryIpAddress,
+	"SoftLayer_Virtual_Guest/getActiveTransaction":      (*Simulator).getVirtualGuestActiveTransaction,
+	"SoftLayer_Virtual_Guest/getActiveTransactions":     (*Simulator).getVirtualGuestActiveTransactions,
+	"SoftLayer_Virtual_Guest/getSshKeys":                (*Simulator).getVirtualGuestSshKeys,
+	"SoftLayer_Virtual_Guest/powerOn":                   (*Simulator).powerOnVirtualGuest,
+	"SoftLayer_Virtual_Guest/powerOff":                  (*Simulator).powerOffVirtualGuest,
+	"SoftLayer_Virtual_Guest/powerOffSoft":              (*Simulator).powerOffVirtualGuest,
+	"SoftLayer_Virtual_Guest/powerCycle":                (*Simulator).rebootVirtualGuest,
+	"SoftLayer_Virtual_Guest/rebootDefault":             (*Simulator).rebootVirtualGuest,
+	"SoftLayer_Virtual_Guest/rebootSoft":                (*Simulator).rebootVirtualGuest,
+	"SoftLayer_Virtual_Guest/rebootHard":                (*Simulator).rebootVirtualGuest,
+	"SoftLayer_Virtual_Guest/reloadOperatingSystem":     (*Simulator).reloadVirtualGuestOperatingSystem,
+	"SoftLayer_Virtual_Guest/isPingable":                (*Simulator).isVirtualGuestPingable,
+	"SoftLayer_Virtual_Guest/activatePrivatePort":       (*Simulator).setVirtualGuestPort,
+	"SoftLayer_Virtual_Guest/activatePublicPort":        (*Simulator).setVirtualGuestPort,
+	"SoftLayer_Virtual_Guest/shutdownPrivatePort":       (*Simulator).setVirtualGuestPort,
+	"SoftLayer_Virtual_Guest/shutdownPublicPort":        (*Simulator).setVirtualGuestPort,
+	"SoftLayer_Virtual_Guest/setUserMetadata":           (*Simulator).setVirtualGuestUserMetadata,
+	"SoftLayer_Virtual_Guest/getUserData":               (*Simulator).getVirtualGuestUserData,
+	"SoftLayer_Virtual_Guest/configureMetadataDisk":     (*Simulator).configureVirtualGuestMetadataDisk,
+	"SoftLayer_Virtual_Guest/attachDiskImage":           (*Simulator).attachVirtualGuestDiskImage,
+	"SoftLayer_Virtual_Guest/detachDiskImage":           (*Simulator).detachVirtualGuestDiskImage,
+	"SoftLayer_Virtual_Guest/setTags":                   (*Simulator).setVirtualGuestTags,
+	"SoftLayer_Virtual_Guest/getTagReferences":          (*Simulator).getVirtualGuestTagReferences,
+	"SoftLayer_Virtual_Guest/getNetworkVlans":           (*Simulator).getVirtualGuestNetworkVlans,
+	"SoftLayer_Virtual_Guest/checkHostDiskAvailability": (*Simulator).checkVirtualGuestHostDiskAvailability,
+	"SoftLayer_Virtual_Guest/getUpgradeItemPrices":      (*Simulator).getVirtualGuestUpgradeItemPrices,
+
+	"SoftLayer_Security_Ssh_Key/createObject":         (*Simulator).createSshKey,
+	"SoftLayer_Security_Ssh_Key/getObject":            (*Simulator).getSshKey,
+	"SoftLayer_Security_Ssh_Key/editObject":           (*Simulator).editSshKey,
+	"SoftLayer_Security_Ssh_Key/deleteObject":         (*Simulator).deleteSshKey,
+	"SoftLayer_Security_Ssh_Key/getSoftwarePasswords": (*Simulator).getSshKeySoftwarePasswords,
+
+	"SoftLayer_Product_Package/getItemPrices": (*Simulator).getPackageItemPrices,
+	"SoftLayer_Product_Order/verifyOrder":     (*Simulator).verifyOrder,
+	"SoftLayer_Product_Order/placeOrder":      (*Simulator).placeOrder,
+
+	"SoftLayer_Network_Storage/getObject": (*Simulator).getNetworkStorage,
+
+	"SoftLayer_Billing_Item_Cancellation_Request/createObject": (*Simulator).createCancellationRequest,
+}
+
+// rawResult is written as is instead of as JSON.
+type rawResult string
+
+type apiError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+//Private methods
+
+func (s *Simulator) newId() int {
+	s.nextId++
+
+	return s.nextId
+}
+
+// advance completes the transactions and cancellations that are due at now.
+func (s *Simulator) advance(now time.Time) {
+	for _, guest := range s.virtualGuests {
+		guest.advance(now, s.config.TransactionDuration)
+	}
+
+	for _, storage := range s.networkStorage {
+		storage.advance(now)
+	}
+}
+
+//Private functions
+
+func newCall(r *http.Request) (call, error) {
+	path := strings.TrimSuffix(strings.Trim(r.URL.Path, "/"), ".json")
+	segments := strings.Split(path, "/")
+
+	c := call{service: segments[0]}
+	segments = segments[1:]
+
+	if len(segments) > 0 {
+		if id, err := strconv.Atoi(segments[0]); err == nil {
+			c.id = id
+			segments = segments[1:]
+		}
+	}
+
+	if len(segments) > 0 {
+		c.method = segments[0]
+		c.arguments = segments[1:]
+	} else {
+		switch r.Method {
+		case "POST":
+			c.method = "createObject"
+		case "PUT":
+			c.method = "editObject"
+		case "DELETE":
+			c.method = "deleteObject"
+		default:
+			c.method = "getObject"
+		}
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return call{}, err
+	}
+
+	if len(bytes.TrimSpace(body)) > 0 {
+		parameters := struct {
+			Parameters json.RawMessage `json:"parameters"`
+		}{}
+		err = json.Unmarshal(body, &parameters)
+		if err == nil && bytes.HasPrefix(bytes.TrimSpace(parameters.Parameters), []byte("[")) {
+			err = json.Unmarshal(parameters.Parameters, &c.parameters)
+		} else if err == nil {
+			// A single parameter may be sent without its array, e.g. by
+			// attachDiskImage.
+			c.parameters = []json.RawMessage{parameters.Parameters}
+		}
+
+		if err != nil {
+			return call{}, newPublicError("Invalid JSON body: %s", err)
+		}
+	}
+
+	if resultLimit := r.URL.Query().Get("resultLimit"); resultLimit != "" {
+		c.resultLimit = &softlayer.ResultLimit{}
+		_, err = fmt.Sscanf(resultLimit, "%d,%d", &c.resultLimit.Offset, &c.resultLimit.Limit)
+		if err != nil {
+			return call{}, newPublicError("Invalid result limit '%s'", resultLimit)
+		}
+	}
+
+	return c, nil
+}
+
+// parameter decodes the parameter at index into value.
+func (c call) parameter(index int, value interface{}) error {
+	if index >= len(c.parameters) {
+		return newPublicError("Invalid number of parameters for %s::%s", c.service, c.method)
+	}
+
+	err := json.Unmarshal(c.parameters[index], value)
+	if err != nil {
+		return newPublicError("Invalid parameter %d for %s::%s: %s", index, c.service, c.method, err)
+	}
+
+	return nil
+}
+
+func newObjectNotFoundError(id int) *apiError {
+	return &apiError{
+		statusCode: http.StatusNotFound,
+		code:       softlayer.SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND,
+		message:    fmt.Sprintf("Unable to find object with id of '%d'.", id),
+	}
+}
+
+func newPublicError(format string, args ...interface{}) *apiError {
+	return &apiError{
+		statusCode: http.StatusInternalServerError,
+		code:       "SoftLayer_Exception_Public",
+		message:    fmt.Sprintf(format, args...),
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	slErr, ok := err.(*apiError)
+	if !ok {
+		slErr = newPublicError("%s", err)
+	}
+
+	body, _ := json.Marshal(map[string]string{
+		"error": slErr.message,
+		"code":  slErr.code,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(slErr.statusCode)
+	w.Write(body)
+}
+
+// writeResult writes result as JSON, applying the result limit of c to the
+// lists.
+func writeResult(w http.ResponseWriter, c call, result interface{}) {
+	if value := reflect.ValueOf(result); value.Kind() == reflect.Slice && c.resultLimit != nil {
+		total := value.Len()
+		start := c.resultLimit.Offset
+		if start > total {
+			start = total
+		}
+		end := total
+		if c.resultLimit.Limit > 0 && start+c.resultLimit.Limit < total {
+			end = start + c.resultLimit.Limit
+		}
+
+		result = value.Slice(start, end).Interface()
+		w.Header().Set("SoftLayer-Total-Items", strconv.Itoa(total))
+	}
+
+	if raw, ok := result.(rawResult); ok {
+		w.Write([]byte(raw))
+		return
+	}
+
+	body, err := json.Marshal(result)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
@@ -0,0 +1,406 @@
+package simulator_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	simulator "github.com/maximilien/softlayer-go/client/simulator"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const (
+	TIMEOUT          = 2 * time.Second
+	POLLING_INTERVAL = 5 * time.Millisecond
+)
+
+var _ = Describe("Simulator", func() {
+	var (
+		server *simulator.Server
+		client softlayer.Client
+
+		accountService      softlayer.SoftLayer_Account_Service
+		virtualGuestService softlayer.SoftLayer_Virtual_Guest_Service
+	)
+
+	BeforeEach(func() {
+		server = simulator.NewServer(simulator.Config{
+			Username:            "fake-username",
+			ApiKey:              "fake-api-key",
+			TransactionDuration: 20 * time.Millisecond,
+		})
+
+		client = slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL), slclient.WithRetryPolicy(slclient.RetryPolicy{MaxAttempts: 1}))
+
+		var err error
+		accountService, err = client.GetSoftLayer_Account_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		virtualGuestService, err = client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	powerState := func(id int) func() string {
+		return func() string {
+			state, err := virtualGuestService.GetPowerState(id)
+			Expect(err).ToNot(HaveOccurred())
+			return state.KeyName
+		}
+	}
+
+	activeTransactions := func(id int) func() int {
+		return func() int {
+			transactions, err := virtualGuestService.GetActiveTransactions(id)
+			Expect(err).ToNot(HaveOccurred())
+			return len(transactions)
+		}
+	}
+
+	createVirtualGuest := func() datatypes.SoftLayer_Virtual_Guest {
+		virtualGuest, err := virtualGuestService.CreateObject(datatypes.SoftLayer_Virtual_Guest_Template{
+			Hostname:                     "fake-hostname",
+			Domain:                       "fake.domain.com",
+			StartCpus:                    2,
+			MaxMemory:                    1024,
+			Datacenter:                   datatypes.Datacenter{Name: "ams01"},
+			HourlyBillingFlag:            true,
+			LocalDiskFlag:                true,
+			OperatingSystemReferenceCode: "UBUNTU_LATEST",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		return virtualGuest
+	}
+
+	createRunningVirtualGuest := func() datatypes.SoftLayer_Virtual_Guest {
+		virtualGuest := createVirtualGuest()
+		Eventually(activeTransactions(virtualGuest.Id), TIMEOUT, POLLING_INTERVAL).Should(Equal(0))
+
+		return virtualGuest
+	}
+
+	Context("#ServeHTTP", func() {
+		It("checks the credentials", func() {
+			client = slclient.NewSoftLayerClient("fake-username", "wrong-api-key", slclient.WithEndpoint(server.URL))
+			accountService, err := client.GetSoftLayer_Account_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = accountService.GetAccountStatus()
+			Expect(softlayer.IsAuthError(err)).To(BeTrue())
+		})
+
+		It("fails on the methods it does not serve", func() {
+			err := client.Invoke(context.Background(), softlayer.Invocation{Service: "SoftLayer_Account", Method: "getFakeObjects"}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a valid method for this service"))
+		})
+
+		It("fails with not found on unknown ids", func() {
+			_, err := virtualGuestService.GetObject(1)
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("applies the result limits", func() {
+			for i := 0; i < 3; i++ {
+				createVirtualGuest()
+			}
+
+			options := &softlayer.RequestOptions{ResultLimit: &softlayer.ResultLimit{Offset: 1, Limit: 1}}
+			virtualGuests, err := accountService.GetVirtualGuestsWithOptions(context.Background(), options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuests).To(HaveLen(1))
+			Expect(options.TotalItems).To(Equal(3))
+		})
+	})
+
+	Context("SoftLayer_Virtual_Guest", func() {
+		It("provisions the created virtual guests over time", func() {
+			virtualGuest := createVirtualGuest()
+			Expect(virtualGuest.Id).ToNot(Equal(0))
+			Expect(virtualGuest.FullyQualifiedDomainName).To(Equal("fake-hostname.fake.domain.com"))
+
+			Expect(powerState(virtualGuest.Id)()).To(Equal("HALTED"))
+			Expect(activeTransactions(virtualGuest.Id)()).To(Equal(1))
+
+			transaction, err := virtualGuestService.GetActiveTransaction(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transaction.TransactionStatus.Name).ToNot(Equal(""))
+
+			_, err = virtualGuestService.GetPrimaryIpAddress(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+
+			Eventually(powerState(virtualGuest.Id), TIMEOUT, POLLING_INTERVAL).Should(Equal("RUNNING"))
+			Expect(activeTransactions(virtualGuest.Id)()).To(Equal(0))
+
+			ipAddress, err := virtualGuestService.GetPrimaryIpAddress(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipAddress).ToNot(Equal(""))
+
+			pingable, err := virtualGuestService.IsPingable(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pingable).To(BeTrue())
+		})
+
+		It("makes the created virtual guests ready", func() {
+			virtualGuest := createVirtualGuest()
+
+			states := []softlayer.ReadyState{}
+			err := virtualGuestService.WaitUntilReady(virtualGuest.Id, softlayer.ReadyOptions{
+				Timeout:      TIMEOUT,
+				PollInterval: POLLING_INTERVAL,
+				Pingable:     true,
+				Progress: func(state softlayer.ReadyState) {
+					states = append(states, state)
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(states[0].ActiveTransactions).To(HaveLen(1))
+			Expect(states[len(states)-1].Ready).To(BeTrue())
+			Expect(states[len(states)-1].PrimaryIpAddress).ToNot(Equal(""))
+		})
+
+		It("tracks the transactions until they complete", func() {
+			virtualGuest := createRunningVirtualGuest()
+
+			_, err := virtualGuestService.SetMetadata(virtualGuest.Id, "fake-metadata")
+			Expect(err).ToNot(HaveOccurred())
+
+			transaction, err := virtualGuestService.ConfigureMetadataDisk(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+
+			operation := virtualGuestService.TrackTransaction(transaction, softlayer.OperationOptions{PollInterval: POLLING_INTERVAL})
+
+			statuses := []softlayer.OperationStatus{}
+			for status := range operation.Statuses() {
+				statuses = append(statuses, status)
+			}
+			Expect(operation.Err()).ToNot(HaveOccurred())
+
+			Expect(statuses).ToNot(BeEmpty())
+			Expect(statuses[0].Name).To(Equal(transaction.TransactionStatus.Name))
+			Expect(activeTransactions(virtualGuest.Id)()).To(Equal(0))
+		})
+
+		It("fails to create virtual guests without their required properties", func() {
+			err := client.Invoke(context.Background(), softlayer.Invocation{
+				Service:    "SoftLayer_Virtual_Guest",
+				Method:     "createObject",
+				Parameters: []interface{}{map[string]interface{}{"hostname": "fake-hostname"}},
+			}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("domain"))
+		})
+
+		It("powers the virtual guests off and on", func() {
+			virtualGuest := createRunningVirtualGuest()
+
+			powered, err := virtualGuestService.PowerOff(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powered).To(BeTrue())
+			Expect(powerState(virtualGuest.Id)()).To(Equal("HALTED"))
+
+			_, err = virtualGuestService.PowerOn(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(activeTransactions(virtualGuest.Id)()).To(Equal(2))
+
+			Eventually(activeTransactions(virtualGuest.Id), TIMEOUT, POLLING_INTERVAL).Should(Equal(0))
+			Expect(powerState(virtualGuest.Id)()).To(Equal("RUNNING"))
+		})
+
+		It("reboots the virtual guests", func() {
+			virtualGuest := createRunningVirtualGuest()
+
+			rebooted, err := virtualGuestService.RebootSoft(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rebooted).To(BeTrue())
+			Expect(powerState(virtualGuest.Id)()).To(Equal("HALTED"))
+
+			Eventually(powerState(virtualGuest.Id), TIMEOUT, POLLING_INTERVAL).Should(Equal("RUNNING"))
+		})
+
+		It("keeps the notes and tags of the virtual guests", func() {
+			virtualGuest := createVirtualGuest()
+
+			edited, err := virtualGuestService.EditObject(virtualGuest.Id, datatypes.SoftLayer_Virtual_Guest{Notes: "fake-notes"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+
+			_, err = virtualGuestService.SetTags(virtualGuest.Id, []string{"tag0", "tag1"})
+			Expect(err).ToNot(HaveOccurred())
+
+			tagReferences, err := virtualGuestService.GetTagReferences(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tagReferences).To(HaveLen(2))
+			Expect(tagReferences[0].Tag.Name).To(Equal("tag0"))
+			Expect(tagReferences[1].Tag.Name).To(Equal("tag1"))
+
+			virtualGuests, err := accountService.GetVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuests).To(HaveLen(1))
+			Expect(virtualGuests[0].Notes).To(Equal("fake-notes"))
+		})
+
+		It("reclaims the deleted virtual guests", func() {
+			virtualGuest := createRunningVirtualGuest()
+
+			deleted, err := virtualGuestService.DeleteObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			virtualGuests, err := accountService.GetVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuests).To(BeEmpty())
+
+			Expect(activeTransactions(virtualGuest.Id)()).To(Equal(1))
+			Eventually(activeTransactions(virtualGuest.Id), TIMEOUT, POLLING_INTERVAL).Should(Equal(0))
+
+			_, err = virtualGuestService.DeleteObject(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("attaches the ephemeral disks with an upgrade order", func() {
+			virtualGuest := createRunningVirtualGuest()
+
+			err := virtualGuestService.AttachEphemeralDisk(virtualGuest.Id, 50)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(activeTransactions(virtualGuest.Id)()).To(Equal(1))
+		})
+
+		It("upgrades the cores and memory with an upgrade order", func() {
+			virtualGuest := createRunningVirtualGuest()
+
+			_, err := virtualGuestService.Upgrade(virtualGuest.Id, softlayer.UpgradeOptions{Cpus: 4, MemoryGb: 8, Disks: map[int]int{2: 100}})
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(activeTransactions(virtualGuest.Id), TIMEOUT, POLLING_INTERVAL).Should(Equal(0))
+
+			upgraded, err := virtualGuestService.GetObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(upgraded.StartCpus).To(Equal(4))
+			Expect(upgraded.MaxMemory).To(Equal(8192))
+		})
+
+		It("reloads the operating system in a transaction that is followed", func() {
+			virtualGuest := createRunningVirtualGuest()
+
+			operation, err := virtualGuestService.ReloadOperatingSystem(virtualGuest.Id, softlayer.ReloadConfig{
+				SshKeyIds:            []int{1234},
+				PostInstallScriptUri: "https://fake.domain.com/post-install.sh",
+			}, softlayer.OperationOptions{PollInterval: POLLING_INTERVAL})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operation.Wait()).ToNot(HaveOccurred())
+			Expect(operation.Transaction().GuestId).To(Equal(virtualGuest.Id))
+
+			reloaded, err := virtualGuestService.GetObject(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reloaded.Id).To(Equal(virtualGuest.Id))
+			Expect(reloaded.PostInstallScriptUri).To(Equal("https://fake.domain.com/post-install.sh"))
+
+			powerState, err := virtualGuestService.GetPowerState(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powerState.KeyName).To(Equal("RUNNING"))
+		})
+
+		It("keeps the user metadata", func() {
+			virtualGuest := createVirtualGuest()
+
+			set, err := virtualGuestService.SetMetadata(virtualGuest.Id, "fake-metadata")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(set).To(BeTrue())
+
+			userData, err := virtualGuestService.GetUserData(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(userData).To(HaveLen(1))
+			Expect(userData[0].Value).To(Equal("ZmFrZS1tZXRhZGF0YQ=="))
+		})
+	})
+
+	Context("SoftLayer_Security_Ssh_Key", func() {
+		It("creates, lists and deletes the SSH keys of the account", func() {
+			sshKeyService, err := client.GetSoftLayer_Security_Ssh_Key_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			sshKey, err := sshKeyService.CreateObject(datatypes.SoftLayer_Security_Ssh_Key{
+				Key:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC fake@host",
+				Label: "fake-label",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sshKey.Id).ToNot(Equal(0))
+			Expect(sshKey.Fingerprint).ToNot(Equal(""))
+			Expect(sshKey.CreateDate).ToNot(BeNil())
+
+			sshKeys, err := accountService.GetSshKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sshKeys).To(HaveLen(1))
+
+			virtualGuest, err := virtualGuestService.CreateObject(datatypes.SoftLayer_Virtual_Guest_Template{
+				Hostname:                     "fake-hostname",
+				Domain:                       "fake.domain.com",
+				StartCpus:                    1,
+				MaxMemory:                    1024,
+				Datacenter:                   datatypes.Datacenter{Name: "ams01"},
+				OperatingSystemReferenceCode: "UBUNTU_LATEST",
+				SshKeys:                      []datatypes.SshKey{{Id: sshKey.Id}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			guestSshKeys, err := virtualGuestService.GetSshKeys(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(guestSshKeys).To(HaveLen(1))
+			Expect(guestSshKeys[0].Label).To(Equal("fake-label"))
+
+			deleted, err := sshKeyService.DeleteObject(sshKey.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			sshKeys, err = accountService.GetSshKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sshKeys).To(BeEmpty())
+		})
+	})
+
+	Context("SoftLayer_Network_Storage", func() {
+		It("orders iSCSI volumes and cancels their billing items", func() {
+			networkStorageService, err := client.GetSoftLayer_Network_Storage_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			volume, err := networkStorageService.CreateIscsiVolume(30, "ams01")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volume.CapacityGb).To(Equal(40))
+			Expect(volume.BillingItem).ToNot(BeNil())
+
+			volume, err = networkStorageService.GetIscsiVolume(volume.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volume.Username).ToNot(Equal(""))
+
+			err = networkStorageService.DeleteIscsiVolume(volume.Id, true)
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() *datatypes.Billing_Item {
+				volume, err := networkStorageService.GetIscsiVolume(volume.Id)
+				Expect(err).ToNot(HaveOccurred())
+				return volume.BillingItem
+			}, TIMEOUT, POLLING_INTERVAL).Should(BeNil())
+		})
+
+		It("rejects orders with unknown prices", func() {
+			productOrderService, err := client.GetSoftLayer_Product_Order_Service()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = productOrderService.PlaceOrder(datatypes.SoftLayer_Product_Order{
+				ComplexType: "SoftLayer_Container_Product_Order_Network_Storage_Iscsi",
+				Prices:      []datatypes.SoftLayer_Item_Price{{Id: 1}},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Price # 1 is not valid for this order."))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+package simulator
+
+import (
+	"strconv"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+// transaction is a provisioning transaction of a virtual guest. It goes
+// through its statuses, each one lasting the TransactionDuration of the
+// simulator, once the transactions queued before it completed.
+type transaction struct {
+	id       int
+	group    string
+	statuses []string
+
+	createDate time.Time
+	startDate  time.Time
+	started    bool
+
+	// onStart and onComplete, when set, change the state of the virtual
+	// guest as the transaction starts and completes.
+	onStart    func()
+	onComplete func()
+}
+
+func (t *transaction) start(startDate time.Time) {
+	t.startDate = startDate
+	t.started = true
+
+	if t.onStart != nil {
+		t.onStart()
+	}
+}
+
+func (t *transaction) endDate(stepDuration time.Duration) time.Time {
+	return t.startDate.Add(time.Duration(len(t.statuses)) * stepDuration)
+}
+
+func (t *transaction) datatype(guestId int, now time.Time, stepDuration time.Duration) datatypes.SoftLayer_Provisioning_Version1_Transaction {
+	createDate := t.createDate
+
+	status := t.statuses[0]
+	statusChangeDate := t.createDate
+
+	if t.started {
+		step := int(now.Sub(t.startDate) / stepDuration)
+		if step >= len(t.statuses) {
+			step = len(t.statuses) - 1
+		}
+
+		status = t.statuses[step]
+		statusChangeDate = t.startDate.Add(time.Duration(step) * stepDuration)
+	}
+
+	// As for the API, the elapsed time is the one spent in the current status.
+	elapsed := now.Sub(statusChangeDate)
+
+	averageDuration := strconv.FormatFloat(stepDuration.Minutes(), 'f', -1, 64)
+
+	return datatypes.SoftLayer_Provisioning_Version1_Transaction{
+		CreateDate:       &createDate,
+		ElapsedSeconds:   int(elapsed.Seconds()),
+		GuestId:          guestId,
+		Id:               t.id,
+		ModifyDate:       &statusChangeDate,
+		StatusChangeDate: &statusChangeDate,
+
+		TransactionGroup: datatypes.TransactionGroup{
+			AverageTimeToComplete: strconv.FormatFloat(float64(len(t.statuses))*stepDuration.Minutes(), 'f', -1, 64),
+			Name:                  t.group,
+		},
+		TransactionStatus: datatypes.TransactionStatus{
+			AverageDuration: averageDuration,
+			FriendlyName:    status,
+			Name:            status,
+		},
+	}
+}
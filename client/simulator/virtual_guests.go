@@ -0,0 +1,585 @@
+package simulator
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const (
+	POWER_STATE_RUNNING = "RUNNING"
+	POWER_STATE_HALTED  = "HALTED"
+)
+
+var powerStateNames = map[string]string{
+	POWER_STATE_RUNNING: "Running",
+	POWER_STATE_HALTED:  "Halted",
+}
+
+type virtualGuest struct {
+	guest datatypes.SoftLayer_Virtual_Guest
+
+	powerState   string
+	sshKeyIds    []int
+	tags         []datatypes.SoftLayer_Tag_Reference
+	userData     []string
+	networkVlans []datatypes.SoftLayer_Network_Vlan
+
+	// A deleted guest is no longer listed by the account, but keeps
+	// answering calls by id, as it does while SoftLayer reclaims it.
+	deleted bool
+
+	transactions       []*transaction
+	lastTransactionEnd time.Time
+}
+
+// queue adds a transaction with statuses after the ones of the guest.
+func (g *virtualGuest) queue(s *Simulator, group string, statuses []string, onStart func(), onComplete func()) *transaction {
+	t := &transaction{
+		id:         s.newId(),
+		group:      group,
+		statuses:   statuses,
+		createDate: time.Now(),
+		onStart:    onStart,
+		onComplete: onComplete,
+	}
+
+	g.transactions = append(g.transactions, t)
+	if len(g.transactions) == 1 {
+		t.start(t.createDate)
+	}
+
+	return t
+}
+
+// advance completes the transactions that ended at now, and starts the next
+// ones as of the end of the previous ones.
+func (g *virtualGuest) advance(now time.Time, stepDuration time.Duration) {
+	for len(g.transactions) > 0 {
+		t := g.transactions[0]
+		if !t.started {
+			startDate := t.createDate
+			if g.lastTransactionEnd.After(startDate) {
+				startDate = g.lastTransactionEnd
+			}
+			t.start(startDate)
+		}
+
+		endDate := t.endDate(stepDuration)
+		if now.Before(endDate) {
+			return
+		}
+
+		if t.onComplete != nil {
+			t.onComplete()
+		}
+
+		g.transactions = g.transactions[1:]
+		g.lastTransactionEnd = endDate
+	}
+}
+
+func (g *virtualGuest) activeTransactions(now time.Time, stepDuration time.Duration) []datatypes.SoftLayer_Provisioning_Version1_Transaction {
+	transactions := []datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	for _, t := range g.transactions {
+		transactions = append(transactions, t.datatype(g.guest.Id, now, stepDuration))
+	}
+
+	return transactions
+}
+
+//Private methods
+
+func (s *Simulator) findVirtualGuest(id int) (*virtualGuest, error) {
+	guest, ok := s.virtualGuests[id]
+	if !ok {
+		return nil, newObjectNotFoundError(id)
+	}
+
+	return guest, nil
+}
+
+// powerTransaction queues the transaction of a power operation, in which the
+// guest is halted, then in targetState once it completes.
+func (s *Simulator) powerTransaction(c call, group string, statuses []string, targetState string) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	guest.queue(s, group, statuses, func() {
+		guest.powerState = POWER_STATE_HALTED
+	}, func() {
+		guest.powerState = targetState
+	})
+
+	return true, nil
+}
+
+func (s *Simulator) createVirtualGuest(c call) (interface{}, error) {
+	template := datatypes.SoftLayer_Virtual_Guest_Template{}
+	err := c.parameter(0, &template)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := []string{}
+	if template.Hostname == "" {
+		missing = append(missing, "hostname")
+	}
+	if template.Domain == "" {
+		missing = append(missing, "domain")
+	}
+	if template.StartCpus <= 0 {
+		missing = append(missing, "startCpus")
+	}
+	if template.MaxMemory <= 0 {
+		missing = append(missing, "maxMemory")
+	}
+	if template.Datacenter.Name == "" {
+		missing = append(missing, "datacenter.name")
+	}
+	if template.OperatingSystemReferenceCode == "" && template.BlockDeviceTemplateGroup == nil {
+		missing = append(missing, "operatingSystemReferenceCode")
+	}
+	if len(missing) > 0 {
+		return nil, newPublicError("Property '%s' must be set to create an instance of 'SoftLayer_Virtual_Guest'.", strings.Join(missing, "', '"))
+	}
+
+	sshKeyIds := []int{}
+	for _, sshKey := range template.SshKeys {
+		if _, ok := s.sshKeys[sshKey.Id]; !ok {
+			return nil, newObjectNotFoundError(sshKey.Id)
+		}
+		sshKeyIds = append(sshKeyIds, sshKey.Id)
+	}
+
+	id := s.newId()
+	now := time.Now()
+	location := &datatypes.SoftLayer_Location{Id: 265592, Name: template.Datacenter.Name, LongName: template.Datacenter.Name}
+
+	guest := &virtualGuest{
+		guest: datatypes.SoftLayer_Virtual_Guest{
+			AccountId:                    s.config.AccountId,
+			CreateDate:                   &now,
+			DedicatedAccountHostOnlyFlag: template.DedicatedAccountHostOnlyFlag,
+			Domain:                       template.Domain,
+			FullyQualifiedDomainName:     template.Hostname + "." + template.Domain,
+			Hostname:                     template.Hostname,
+			Id:                           id,
+			MaxCpu:                       template.StartCpus,
+			MaxCpuUnits:                  "CORE",
+			MaxMemory:                    template.MaxMemory,
+			PostInstallScriptUri:         template.PostInstallScriptUri,
+			PrivateNetworkOnlyFlag:       template.PrivateNetworkOnlyFlag,
+			StartCpus:                    template.StartCpus,
+			StatusId:                     1001,
+			Uuid:                         fmt.Sprintf("00000000-0000-0000-0000-%012d", id),
+			GlobalIdentifier:             fmt.Sprintf("00000000-0000-0000-0001-%012d", id),
+
+			Location:   location,
+			Datacenter: location,
+
+			OperatingSystem: &datatypes.SoftLayer_Operating_System{
+				Passwords: []datatypes.SoftLayer_Password{
+					{Username: "root", Password: fmt.Sprintf("password-%d", id)},
+				},
+			},
+		},
+		powerState: POWER_STATE_HALTED,
+		sshKeyIds:  sshKeyIds,
+	}
+
+	for _, userData := range template.UserData {
+		guest.userData = append(guest.userData, userData.Value)
+	}
+
+	if !template.PrivateNetworkOnlyFlag {
+		guest.networkVlans = append(guest.networkVlans, datatypes.SoftLayer_Network_Vlan{
+			AccountId:  s.config.AccountId,
+			Id:         293731,
+			Name:       "Public",
+			VlanNumber: 809,
+		})
+	}
+	guest.networkVlans = append(guest.networkVlans, datatypes.SoftLayer_Network_Vlan{
+		AccountId:  s.config.AccountId,
+		Id:         400972,
+		Name:       "Private",
+		VlanNumber: 1483,
+	})
+
+	guest.queue(s, "Cloud Instance Provision", []string{"CLOUD_PROVISION_SETUP", "CLOUD_INSTALL_OS", "CLOUD_CONFIGURE_NETWORK", "CLOUD_PROVISION_COMPLETE"}, nil, func() {
+		guest.powerState = POWER_STATE_RUNNING
+		guest.guest.PrimaryBackendIpAddress = fmt.Sprintf("10.%d.%d.%d", id>>16&0xff, id>>8&0xff, id&0xff)
+		if !guest.guest.PrivateNetworkOnlyFlag {
+			guest.guest.PrimaryIpAddress = fmt.Sprintf("169.%d.%d.%d", id>>16&0xff, id>>8&0xff, id&0xff)
+		}
+	})
+
+	s.virtualGuests[id] = guest
+
+	return guest.guest, nil
+}
+
+func (s *Simulator) getVirtualGuest(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return guest.guest, nil
+}
+
+func (s *Simulator) editVirtualGuest(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	template := datatypes.SoftLayer_Virtual_Guest{}
+	err = c.parameter(0, &template)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.Notes != "" {
+		guest.guest.Notes = template.Notes
+	}
+	if template.Hostname != "" {
+		guest.guest.Hostname = template.Hostname
+	}
+	if template.Domain != "" {
+		guest.guest.Domain = template.Domain
+	}
+	guest.guest.FullyQualifiedDomainName = guest.guest.Hostname + "." + guest.guest.Domain
+
+	now := time.Now()
+	guest.guest.ModifyDate = &now
+
+	return true, nil
+}
+
+func (s *Simulator) deleteVirtualGuest(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	if guest.deleted {
+		return nil, newPublicError("Cancellation of virtual guest %d is already in progress.", c.id)
+	}
+
+	guest.deleted = true
+	guest.queue(s, "Cloud Reclaim", []string{"CLOUD_RECLAIM_PREP", "RECLAIM_WAIT"}, nil, func() {
+		guest.powerState = POWER_STATE_HALTED
+	})
+
+	return true, nil
+}
+
+func (s *Simulator) getVirtualGuestPowerState(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return datatypes.SoftLayer_Virtual_Guest_Power_State{
+		KeyName: guest.powerState,
+		Name:    powerStateNames[guest.powerState],
+	}, nil
+}
+
+func (s *Simulator) getVirtualGuestPrimaryIpAddress(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The library reads the address as the raw body of the response.
+	return rawResult(guest.guest.PrimaryIpAddress), nil
+}
+
+func (s *Simulator) getVirtualGuestActiveTransaction(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := guest.activeTransactions(time.Now(), s.config.TransactionDuration)
+	if len(transactions) == 0 {
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, nil
+	}
+
+	return transactions[0], nil
+}
+
+func (s *Simulator) getVirtualGuestActiveTransactions(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return guest.activeTransactions(time.Now(), s.config.TransactionDuration), nil
+}
+
+func (s *Simulator) getVirtualGuestSshKeys(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	sshKeys := []datatypes.SoftLayer_Security_Ssh_Key{}
+	for _, id := range guest.sshKeyIds {
+		if sshKey, ok := s.sshKeys[id]; ok {
+			sshKeys = append(sshKeys, *sshKey)
+		}
+	}
+
+	return sshKeys, nil
+}
+
+func (s *Simulator) powerOnVirtualGuest(c call) (interface{}, error) {
+	return s.powerTransaction(c, "Cloud Power On", []string{"CLOUD_POWER_ON"}, POWER_STATE_RUNNING)
+}
+
+func (s *Simulator) powerOffVirtualGuest(c call) (interface{}, error) {
+	return s.powerTransaction(c, "Cloud Power Off", []string{"CLOUD_POWER_OFF"}, POWER_STATE_HALTED)
+}
+
+func (s *Simulator) rebootVirtualGuest(c call) (interface{}, error) {
+	return s.powerTransaction(c, "Cloud Reboot", []string{"CLOUD_POWER_OFF", "CLOUD_POWER_ON"}, POWER_STATE_RUNNING)
+}
+
+// reloadVirtualGuestOperatingSystem queues the reload transaction, in which
+// the guest is halted, then running with the SSH keys and post install script
+// of the configuration.
+func (s *Simulator) reloadVirtualGuestOperatingSystem(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	var token string
+	err = c.parameter(0, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	if token == "" {
+		return nil, newPublicError("A confirmation token is required to reload the operating system.")
+	}
+
+	config := datatypes.SoftLayer_Container_Hardware_Server_Configuration{}
+	err = c.parameter(1, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(guest.transactions) > 0 {
+		return nil, newPublicError("Unable to reload the operating system of guest %d, it has an active transaction.", guest.guest.Id)
+	}
+
+	statuses := []string{"CLOUD_RECLAIM_PREP", "CLOUD_INSTALL_OS", "CLOUD_CONFIGURE_NETWORK", "CLOUD_POWER_ON"}
+	guest.queue(s, "OS Reload", statuses, func() {
+		guest.powerState = POWER_STATE_HALTED
+	}, func() {
+		guest.powerState = POWER_STATE_RUNNING
+		if len(config.SshKeyIds) > 0 {
+			guest.sshKeyIds = config.SshKeyIds
+		}
+		guest.guest.PostInstallScriptUri = config.PostInstallScriptUri
+	})
+
+	return rawResult("1"), nil
+}
+
+func (s *Simulator) isVirtualGuestPingable(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return guest.powerState == POWER_STATE_RUNNING && guest.guest.PrimaryIpAddress != "", nil
+}
+
+func (s *Simulator) setVirtualGuestPort(c call) (interface{}, error) {
+	_, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+func (s *Simulator) setVirtualGuestUserMetadata(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	userData := []string{}
+	err = c.parameter(0, &userData)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, value := range userData {
+		if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+			return nil, newPublicError("User data must be base64 encoded.")
+		}
+	}
+
+	guest.userData = userData
+
+	return true, nil
+}
+
+func (s *Simulator) getVirtualGuestUserData(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []datatypes.SoftLayer_Virtual_Guest_Attribute{}
+	for _, value := range guest.userData {
+		attributes = append(attributes, datatypes.SoftLayer_Virtual_Guest_Attribute{
+			Value: value,
+			Type: datatypes.SoftLayer_Virtual_Guest_Attribute_Type{
+				Keyname: "USER_DATA",
+				Name:    "User Data",
+			},
+		})
+	}
+
+	return attributes, nil
+}
+
+func (s *Simulator) configureVirtualGuestMetadataDisk(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	t := guest.queue(s, "Configure Metadata Disk", []string{"CLOUD_CONFIGURE_METADATA_DISK"}, nil, nil)
+
+	return t.datatype(guest.guest.Id, time.Now(), s.config.TransactionDuration), nil
+}
+
+func (s *Simulator) attachVirtualGuestDiskImage(c call) (interface{}, error) {
+	return s.diskImageTransaction(c, "Attach Disk Image", "CLOUD_ATTACH_DISK_IMAGE")
+}
+
+func (s *Simulator) detachVirtualGuestDiskImage(c call) (interface{}, error) {
+	return s.diskImageTransaction(c, "Detach Disk Image", "CLOUD_DETACH_DISK_IMAGE")
+}
+
+func (s *Simulator) diskImageTransaction(c call, group string, status string) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	parameter := datatypes.SoftLayer_Virtual_GuestInitParameter{}
+	err = c.parameter(0, &parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	if parameter.ImageId == 0 {
+		return nil, newPublicError("Property 'imageId' must be set.")
+	}
+
+	t := guest.queue(s, group, []string{status}, nil, nil)
+
+	return t.datatype(guest.guest.Id, time.Now(), s.config.TransactionDuration), nil
+}
+
+func (s *Simulator) setVirtualGuestTags(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := ""
+	err = c.parameter(0, &tags)
+	if err != nil {
+		return nil, err
+	}
+
+	guest.tags = []datatypes.SoftLayer_Tag_Reference{}
+	for _, name := range strings.Split(tags, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		tagId := s.newId()
+		guest.tags = append(guest.tags, datatypes.SoftLayer_Tag_Reference{
+			Id:              s.newId(),
+			ResourceTableId: guest.guest.Id,
+			Tag: datatypes.TagReference{
+				AccountId: s.config.AccountId,
+				Id:        tagId,
+				Name:      name,
+			},
+			TagId: tagId,
+			TagType: datatypes.TagType{
+				Description: "CCI",
+				KeyName:     "GUEST",
+			},
+			TagTypeId: 2,
+		})
+	}
+
+	return true, nil
+}
+
+func (s *Simulator) getVirtualGuestTagReferences(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]datatypes.SoftLayer_Tag_Reference{}, guest.tags...), nil
+}
+
+func (s *Simulator) getVirtualGuestNetworkVlans(c call) (interface{}, error) {
+	guest, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]datatypes.SoftLayer_Network_Vlan{}, guest.networkVlans...), nil
+}
+
+func (s *Simulator) checkVirtualGuestHostDiskAvailability(c call) (interface{}, error) {
+	_, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.arguments) == 0 {
+		return nil, newPublicError("Invalid number of parameters for %s::%s", c.service, c.method)
+	}
+
+	capacity, err := strconv.Atoi(c.arguments[0])
+	if err != nil || capacity <= 0 {
+		return nil, newPublicError("Invalid disk capacity '%s'", c.arguments[0])
+	}
+
+	return true, nil
+}
+
+func (s *Simulator) getVirtualGuestUpgradeItemPrices(c call) (interface{}, error) {
+	_, err := s.findVirtualGuest(c.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return upgradeItemPrices(), nil
+}
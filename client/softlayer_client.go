@@ -2,24 +2,30 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net/http"
-	"net/http/httputil"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"text/template"
+	"time"
 
+	redaction "github.com/maximilien/softlayer-go/client/redaction"
 	services "github.com/maximilien/softlayer-go/services"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
 const (
-	SOFTLAYER_API_URL  = "api.softlayer.com/rest/v3"
-	TEMPLATE_ROOT_PATH = "templates"
-	SL_GO_NON_VERBOSE  = "SL_GO_NON_VERBOSE"
+	SOFTLAYER_API_URL         = "api.softlayer.com/rest/v3"
+	SOFTLAYER_PRIVATE_API_URL = "api.service.softlayer.com/rest/v3"
+	SOFTLAYER_XMLRPC_API_URL  = "api.softlayer.com/xmlrpc/v3"
+	SOFTLAYER_GO_USER_AGENT   = "softlayer-go"
+	TEMPLATE_ROOT_PATH        = "templates"
 )
 
 type softLayerClient struct {
@@ -28,14 +34,27 @@ type softLayerClient struct {
 
 	templatePath string
 
-	httpClient *http.Client
+	endpoint  string
+	userAgent string
+
+	httpClient       *http.Client
+	httpClientConfig httpClientConfig
+
+	transport transport
+
+	// mutex guards retryPolicy and rateLimiter, which may be replaced while
+	// requests are sent, e.g. by the pages of a Pager.
+	mutex       sync.RWMutex
+	retryPolicy RetryPolicy
+	rateLimiter *RateLimiter
 
 	softLayerServices map[string]softlayer.Service
 
-	nonVerbose bool
+	logger    Logger
+	logBodies bool
 }
 
-func NewSoftLayerClient(username, apiKey string) *softLayerClient {
+func NewSoftLayerClient(username, apiKey string, options ...ClientOption) *softLayerClient {
 	pwd, _ := os.Getwd()
 	slc := &softLayerClient{
 		username: username,
@@ -43,16 +62,22 @@ func NewSoftLayerClient(username, apiKey string) *softLayerClient {
 
 		templatePath: filepath.Join(pwd, TEMPLATE_ROOT_PATH),
 
-		httpClient: &http.Client{
-			Transport: &http.Transport{
-				Proxy: http.ProxyFromEnvironment,
-			},
-		},
+		endpoint:  fmt.Sprintf("https://%s", SOFTLAYER_API_URL),
+		userAgent: SOFTLAYER_GO_USER_AGENT,
 
-		nonVerbose: checkNonVerbose(),
+		retryPolicy: DefaultRetryPolicy(),
+
+		logger: noopLogger{},
 
 		softLayerServices: map[string]softlayer.Service{},
 	}
+	slc.transport = &restTransport{client: slc}
+
+	for _, option := range options {
+		option(slc)
+	}
+
+	slc.httpClient = slc.httpClientConfig.newHttpClient()
 
 	slc.initSoftLayerServices()
 
@@ -116,7 +141,7 @@ func (slc *softLayerClient) GetSoftLayer_Product_Package_Service() (softlayer.So
 }
 
 func (slc *softLayerClient) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service() (softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, error) {
-	slService, err := slc.GetService("SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service")
+	slService, err := slc.GetService("SoftLayer_Virtual_Guest_Block_Device_Template_Group")
 	if err != nil {
 		return nil, err
 	}
@@ -163,22 +188,64 @@ func (slc *softLayerClient) GetSoftLayer_Hardware_Service() (softlayer.SoftLayer
 //Public methods
 
 func (slc *softLayerClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
-	url := fmt.Sprintf("https://%s:%s@%s/%s", slc.username, slc.apiKey, SOFTLAYER_API_URL, path)
+	return slc.DoRawHttpRequestWithObjectMaskWithContext(context.Background(), path, masks, requestType, requestBody)
+}
 
-	url += "?objectMask="
-	for i := 0; i < len(masks); i++ {
-		url += masks[i]
-		if i != len(masks)-1 {
-			url += ";"
-		}
+func (slc *softLayerClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	return slc.DoRawHttpRequestWithContext(context.Background(), path, requestType, requestBody)
+}
+
+func (slc *softLayerClient) DoRawHttpRequestWithObjectMaskWithContext(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	options := &softlayer.RequestOptions{Mask: softlayer.ParseLegacyObjectMasks(masks)}
+	return slc.makeHttpRequest(ctx, newRequest(path, options, requestType, requestBody), nil)
+}
+
+func (slc *softLayerClient) DoRawHttpRequestWithContext(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	return slc.makeHttpRequest(ctx, newRequest(path, nil, requestType, requestBody), nil)
+}
+
+func (slc *softLayerClient) DoHttpRequestWithContext(ctx context.Context, path string, options *softlayer.RequestOptions, requestType string, requestBody *bytes.Buffer, result interface{}) error {
+	_, err := slc.makeHttpRequest(ctx, newRequest(path, options, requestType, requestBody), result)
+	return err
+}
+
+// Invoke calls the method of the invocation and decodes its response into
+// result, which may be nil when the response is not needed. Methods with
+// parameters are sent as POST requests, the others as GET requests.
+func (slc *softLayerClient) Invoke(ctx context.Context, invocation softlayer.Invocation, result interface{}) error {
+	if err := invocation.Validate(); err != nil {
+		return err
+	}
+
+	requestType, requestBody, err := invocationRequestBody(invocation)
+	if err != nil {
+		return err
 	}
 
-	return slc.makeHttpRequest(url, requestType, requestBody)
+	_, err = slc.makeHttpRequest(ctx, newRequest(invocation.Path(), invocation.Options, requestType, requestBody), result)
+	return err
 }
 
-func (slc *softLayerClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
-	url := fmt.Sprintf("https://%s:%s@%s/%s", slc.username, slc.apiKey, SOFTLAYER_API_URL, path)
-	return slc.makeHttpRequest(url, requestType, requestBody)
+func (slc *softLayerClient) SetRetryPolicy(retryPolicy RetryPolicy) {
+	slc.mutex.Lock()
+	defer slc.mutex.Unlock()
+
+	slc.retryPolicy = retryPolicy
+}
+
+// SetRateLimit limits the requests sent by the client, across all goroutines,
+// to requestsPerSecond with bursts of up to burst requests. A requestsPerSecond
+// of 0 removes the limit.
+func (slc *softLayerClient) SetRateLimit(requestsPerSecond float64, burst int) {
+	slc.mutex.Lock()
+	defer slc.mutex.Unlock()
+
+	if requestsPerSecond <= 0 {
+		slc.rateLimiter = nil
+		return
+	}
+
+	slc.rateLimiter = NewRateLimiter(requestsPerSecond, burst)
 }
 
 func (slc *softLayerClient) GenerateRequestBody(templateData interface{}) (*bytes.Buffer, error) {
@@ -198,7 +265,15 @@ func (slc *softLayerClient) HasErrors(body map[string]interface{}) error {
 	if errString, ok := body["error"]; !ok {
 		return nil
 	} else {
-		return errors.New(errString.(string))
+		slErr := &softlayer.SoftLayerError{
+			Message: fmt.Sprintf("%v", errString),
+		}
+
+		if code, ok := body["code"]; ok {
+			slErr.Code = fmt.Sprintf("%v", code)
+		}
+
+		return slErr
 	}
 }
 
@@ -231,59 +306,175 @@ func (slc *softLayerClient) initSoftLayerServices() {
 	slc.softLayerServices["SoftLayer_Hardware"] = services.NewSoftLayer_Hardware_Service(slc)
 }
 
-func (slc *softLayerClient) makeHttpRequest(url string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
-	req, err := http.NewRequest(requestType, url, requestBody)
-	if err != nil {
-		return nil, err
+// makeHttpRequest sends r, retrying it as allowed by the retry policy. When
+// result is not nil the response is decoded into it as it is read and the
+// returned body is nil.
+func (slc *softLayerClient) makeHttpRequest(ctx context.Context, r request, result interface{}) ([]byte, error) {
+	stats := requestStatsFromContext(ctx)
+	stats.Requests++
+
+	slc.mutex.RLock()
+	retryPolicy, rateLimiter := slc.retryPolicy, slc.rateLimiter
+	slc.mutex.RUnlock()
+
+	attempts := 0
+	for {
+		attempts++
+		stats.Attempts++
+
+		if rateLimiter != nil {
+			wait, err := rateLimiter.Wait(ctx)
+			stats.RateLimitWait += wait
+			if err != nil {
+				slErr := newSoftLayerTransportError(r.path, r.requestType, err)
+				slErr.Attempts = attempts
+
+				return nil, slErr
+			}
+		}
+
+		responseBody, err := slc.transport.do(ctx, r, result, attempts)
+		if err == nil {
+			return responseBody, nil
+		}
+
+		if attempts >= retryPolicy.MaxAttempts || !retryPolicy.ShouldRetry(r.requestType, r.method, err) {
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			if !ok {
+				slErr = newSoftLayerTransportError(r.path, r.requestType, err)
+			}
+			slErr.Attempts = attempts
+
+			slc.logger.Log(LOG_LEVEL_ERROR, "softlayer-go request failed", LogFields{
+				"service":  r.service,
+				"method":   r.method,
+				"path":     r.path,
+				"verb":     r.requestType,
+				"status":   slErr.StatusCode,
+				"attempts": attempts,
+				"error":    slc.redact(slErr.Message),
+			})
+
+			return responseBody, slErr
+		}
+
+		backoff := retryPolicy.Backoff(attempts)
+		slc.logger.Log(LOG_LEVEL_DEBUG, "softlayer-go request will be retried", LogFields{
+			"service": r.service,
+			"method":  r.method,
+			"path":    r.path,
+			"verb":    r.requestType,
+			"attempt": attempts,
+			"backoff": backoff,
+		})
+
+		select {
+		case <-ctx.Done():
+			slErr := newSoftLayerTransportError(r.path, r.requestType, ctx.Err())
+			slErr.Attempts = attempts
+
+			return nil, slErr
+		case <-time.After(backoff):
+		}
 	}
+}
 
-	bs, err := httputil.DumpRequest(req, true)
-	if err != nil {
-		return nil, err
+func (slc *softLayerClient) redact(text string) string {
+	return redaction.Body([]byte(text), REDACTED, slc.apiKey)
+}
+
+//Private helper methods
+
+func newSoftLayerError(path string, requestType string, statusCode int, responseBody []byte) *softlayer.SoftLayerError {
+	service, method := serviceAndMethodFromPath(path, requestType)
+
+	slErr := &softlayer.SoftLayerError{
+		StatusCode: statusCode,
+		Message:    http.StatusText(statusCode),
+		Service:    service,
+		Method:     method,
+		Path:       path,
+		Body:       responseBody,
 	}
 
-	if !slc.nonVerbose {
-		fmt.Fprintf(os.Stderr, "\n---\n[softlayer-go] Request:\n%s\n", string(bs))
+	errorBody := struct {
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+	if err := json.Unmarshal(responseBody, &errorBody); err == nil && errorBody.Error != "" {
+		slErr.Message = errorBody.Error
+		slErr.Code = errorBody.Code
 	}
 
-	resp, err := slc.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	return slErr
+}
+
+func newInvalidJsonResponseError(r request, statusCode int, responseBody []byte, err error) *softlayer.SoftLayerError {
+	return &softlayer.SoftLayerError{
+		StatusCode: statusCode,
+		Message:    fmt.Sprintf("failed to decode JSON response, err message '%s'", err.Error()),
+		Service:    r.service,
+		Method:     r.method,
+		Path:       r.path,
+		Body:       responseBody,
+		Err:        err,
 	}
+}
 
-	defer resp.Body.Close()
+func newSoftLayerTransportError(path string, requestType string, err error) *softlayer.SoftLayerError {
+	service, method := serviceAndMethodFromPath(path, requestType)
 
-	bs, err = httputil.DumpResponse(resp, true)
-	if err != nil {
-		return nil, err
+	return &softlayer.SoftLayerError{
+		Message: err.Error(),
+		Service: service,
+		Method:  method,
+		Path:    path,
+		Err:     err,
+	}
+}
+
+func invocationRequestBody(invocation softlayer.Invocation) (string, *bytes.Buffer, error) {
+	if len(invocation.Parameters) == 0 {
+		return "GET", new(bytes.Buffer), nil
 	}
 
-	if !slc.nonVerbose {
-		fmt.Fprintf(os.Stderr, "[softlayer-go] Response:\n%s\n", string(bs))
+	parameters := map[string]interface{}{
+		"parameters": invocation.Parameters,
 	}
 
-	responseBody, err := ioutil.ReadAll(resp.Body)
+	requestBody, err := json.Marshal(parameters)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
 
-	return responseBody, nil
+	return "POST", bytes.NewBuffer(requestBody), nil
 }
 
-//Private helper methods
+func serviceAndMethodFromPath(path string, requestType string) (string, string) {
+	segments := strings.Split(strings.TrimSuffix(path, ".json"), "/")
+	service := segments[0]
+
+	if len(segments) >= 3 {
+		return service, segments[2]
+	}
 
-func checkNonVerbose() bool {
-	slGoNonVerbose := os.Getenv(SL_GO_NON_VERBOSE)
-	switch slGoNonVerbose {
-	case "yes":
-		return true
-	case "YES":
-		return true
-	case "true":
-		return true
-	case "TRUE":
-		return true
+	if len(segments) == 2 {
+		if _, err := strconv.Atoi(segments[1]); err != nil {
+			return service, segments[1]
+		}
+	}
+
+	switch requestType {
+	case "POST":
+		if len(segments) == 1 {
+			return service, "createObject"
+		}
+		return service, "editObject"
+	case "PUT":
+		return service, "editObject"
+	case "DELETE":
+		return service, "deleteObject"
 	}
 
-	return false
+	return service, "getObject"
 }
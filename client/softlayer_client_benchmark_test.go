@@ -0,0 +1,139 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"strings"
+	"testing"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var benchmarkObjectMask = []string{"id", "hostname", "domain", "operatingSystem.passwords.password"}
+
+func newFixtureServer(b *testing.B, fixture string) *httptest.Server {
+	response, err := common.ReadJsonTestFixtures("services", fixture)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(response)
+	}))
+}
+
+// legacyRequest is the request path before auth moved to a header: the URL is
+// built with += and embeds the credentials, and both the request and the
+// response are dumped whether or not they are logged.
+func legacyRequest(httpClient *http.Client, endpoint string, path string, masks []string, result interface{}) error {
+	url := fmt.Sprintf("http://%s:%s@%s/%s", "fake-username", "fake-api-key", strings.TrimPrefix(endpoint, "http://"), path)
+
+	url += "?objectMask="
+	for i := 0; i < len(masks); i++ {
+		url += masks[i]
+		if i != len(masks)-1 {
+			url += ";"
+		}
+	}
+
+	req, err := http.NewRequest("GET", url, new(bytes.Buffer))
+	if err != nil {
+		return err
+	}
+
+	_, err = httputil.DumpRequest(req, true)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = httputil.DumpResponse(resp, true)
+	if err != nil {
+		return err
+	}
+
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(responseBody, result)
+}
+
+func BenchmarkLegacyGetObject(b *testing.B) {
+	server := newFixtureServer(b, "SoftLayer_Virtual_Guest_Service_getObject.json")
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: &http.Transport{}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
+		if err := legacyRequest(httpClient, server.URL, "SoftLayer_Virtual_Guest/1234567/getObject.json", benchmarkObjectMask, &virtualGuest); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetObject(b *testing.B) {
+	server := newFixtureServer(b, "SoftLayer_Virtual_Guest_Service_getObject.json")
+	defer server.Close()
+
+	client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
+		if err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Virtual_Guest/1234567/getObject.json", &softlayer.RequestOptions{Mask: softlayer.NewObjectMask(benchmarkObjectMask...)}, "GET", new(bytes.Buffer), &virtualGuest); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLegacyGetVirtualGuests(b *testing.B) {
+	server := newFixtureServer(b, "SoftLayer_Account_Service_getVirtualGuests.json")
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: &http.Transport{}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
+		if err := legacyRequest(httpClient, server.URL, "SoftLayer_Account/getVirtualGuests.json", nil, &virtualGuests); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetVirtualGuests(b *testing.B) {
+	server := newFixtureServer(b, "SoftLayer_Account_Service_getVirtualGuests.json")
+	defer server.Close()
+
+	client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
+		if err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Account/getVirtualGuests.json", nil, "GET", new(bytes.Buffer), &virtualGuests); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
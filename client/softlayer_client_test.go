@@ -1,12 +1,21 @@
 package client_test
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	slclient "github.com/maximilien/softlayer-go/client"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
@@ -120,4 +129,330 @@ var _ = Describe("SoftLayerClient", func() {
 			Expect(hardwareService).ToNot(BeNil())
 		})
 	})
+
+	Context("#GetSoftLayer_Virtual_Guest_Block_Device_Template_Group", func() {
+		It("returns an instance implemementing the SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service interface", func() {
+			var templateGroupService softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service
+			templateGroupService, err := client.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(templateGroupService).ToNot(BeNil())
+		})
+	})
+
+	Context("#DoRawHttpRequestWithContext", func() {
+		It("fails without sending the request when the context is already cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := client.DoRawHttpRequestWithContext(ctx, "SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(context.Canceled.Error()))
+		})
+
+		It("does not retry a cancelled request", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := client.DoRawHttpRequestWithContext(ctx, "SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slErr.Attempts).To(Equal(1))
+			Expect(slErr.Service).To(Equal("SoftLayer_Account"))
+			Expect(slErr.Method).To(Equal("getAccountStatus"))
+		})
+
+		It("reports the attempts and the rate limit wait on the request stats", func() {
+			slClient := slclient.NewSoftLayerClient(username, apiKey)
+			slClient.SetRateLimit(1, 1)
+
+			stats := slclient.RequestStats{}
+			ctx, cancel := context.WithTimeout(slclient.WithRequestStats(context.Background(), &stats), 50*time.Millisecond)
+			defer cancel()
+
+			_, err := slClient.DoRawHttpRequestWithContext(ctx, "SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(err).To(HaveOccurred())
+
+			_, err = slClient.DoRawHttpRequestWithContext(ctx, "SoftLayer_Account/getAccountStatus.json", "GET", new(bytes.Buffer))
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+
+			Expect(stats.Requests).To(Equal(2))
+			Expect(stats.Attempts).To(BeNumerically(">=", 2))
+		})
+	})
+
+	Context("#DoHttpRequestWithContext", func() {
+		var (
+			server  *httptest.Server
+			handler http.HandlerFunc
+
+			requests []*http.Request
+		)
+
+		BeforeEach(func() {
+			requests = []*http.Request{}
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id": 1234567, "hostname": "fake-hostname"}`))
+			}
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r)
+				handler(w, r)
+			}))
+
+			client = slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("decodes the response into the result", func() {
+			virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
+			err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Virtual_Guest/1234567/getObject.json", nil, "GET", new(bytes.Buffer), &virtualGuest)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(virtualGuest.Id).To(Equal(1234567))
+			Expect(virtualGuest.Hostname).To(Equal("fake-hostname"))
+			Expect(requests[0].URL.RawQuery).To(Equal(""))
+		})
+
+		It("sends the object mask escaped", func() {
+			options := &softlayer.RequestOptions{
+				Mask: softlayer.NewObjectMask("id", "hostname").Nest("networkComponents", softlayer.NewObjectMask("primaryIpAddress")),
+			}
+
+			virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
+			err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Virtual_Guest/1234567/getObject.json", options, "GET", new(bytes.Buffer), &virtualGuest)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests[0].URL.RawQuery).To(Equal("objectMask=mask%5Bid%2Chostname%2CnetworkComponents%5BprimaryIpAddress%5D%5D"))
+			Expect(requests[0].URL.Query().Get("objectMask")).To(Equal("mask[id,hostname,networkComponents[primaryIpAddress]]"))
+		})
+
+		It("sends the object filter along with the object mask", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`[{"id": 1234567}]`))
+			}
+
+			options := &softlayer.RequestOptions{
+				Mask:   softlayer.NewObjectMask("id"),
+				Filter: softlayer.NewObjectFilter().Set("virtualGuests.hostname", softlayer.BeginsWith("web-")),
+			}
+
+			virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
+			err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Account/getVirtualGuests.json", options, "GET", new(bytes.Buffer), &virtualGuests)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuests).To(HaveLen(1))
+
+			Expect(requests[0].URL.Query().Get("objectMask")).To(Equal("mask[id]"))
+			Expect(requests[0].URL.Query().Get("objectFilter")).To(Equal(`{"virtualGuests":{"hostname":{"operation":"^= web-"}}}`))
+		})
+
+		It("sends the result limit and reads the total number of items", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("SoftLayer-Total-Items", "250")
+				w.Write([]byte(`[{"id": 1234567}]`))
+			}
+
+			options := &softlayer.RequestOptions{
+				ResultLimit: &softlayer.ResultLimit{Offset: 100, Limit: 50},
+			}
+
+			virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
+			err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Account/getVirtualGuests.json", options, "GET", new(bytes.Buffer), &virtualGuests)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests[0].URL.Query().Get("resultLimit")).To(Equal("100,50"))
+			Expect(options.TotalItems).To(Equal(250))
+		})
+
+		It("converts the legacy object masks", func() {
+			_, err := client.DoRawHttpRequestWithObjectMask("SoftLayer_Virtual_Guest/1234567/getObject.json", []string{"id", "operatingSystem.passwords.password", "operatingSystem.passwords.username"}, "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests[0].URL.Query().Get("objectMask")).To(Equal("mask[id,operatingSystem[passwords[password,username]]]"))
+		})
+
+		It("keeps the filteredMask of the legacy object masks", func() {
+			_, err := client.DoRawHttpRequestWithObjectMask("SoftLayer_Account/getVirtualGuests.json", []string{"filteredMask[id,tagReferences.tag.name]"}, "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests[0].URL.Query().Get("objectMask")).To(Equal("filteredMask[id,tagReferences[tag[name]]]"))
+		})
+
+		It("sends the legacy object masks it cannot parse verbatim", func() {
+			_, err := client.DoRawHttpRequestWithObjectMask("SoftLayer_Virtual_Guest/1234567/getObject.json", []string{"id", "networkComponents[primaryIpAddress"}, "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests[0].URL.Query().Get("objectMask")).To(Equal("id;networkComponents[primaryIpAddress"))
+		})
+
+		It("negotiates gzip compressed responses", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("Accept-Encoding")).To(Equal("gzip"))
+
+				w.Header().Set("Content-Encoding", "gzip")
+				gzipWriter := gzip.NewWriter(w)
+				gzipWriter.Write([]byte(`{"id": 1234567, "hostname": "fake-hostname"}`))
+				gzipWriter.Close()
+			}
+
+			virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
+			err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Virtual_Guest/1234567/getObject.json", nil, "GET", new(bytes.Buffer), &virtualGuest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuest.Hostname).To(Equal("fake-hostname"))
+
+			response, err := client.DoRawHttpRequest("SoftLayer_Virtual_Guest/1234567/getObject.json", "GET", new(bytes.Buffer))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(Equal([]byte(`{"id": 1234567, "hostname": "fake-hostname"}`)))
+		})
+
+		It("fails with a SoftLayerError when the response is not valid JSON", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id": 12345`))
+			}
+
+			virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
+			err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Virtual_Guest/1234567/getObject.json", nil, "GET", new(bytes.Buffer), &virtualGuest)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to decode JSON response"))
+
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slErr.Method).To(Equal("getObject"))
+			Expect(slErr.Attempts).To(Equal(1))
+		})
+
+		It("does not decode API errors into the result", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error": "Unable to find object", "code": "SoftLayer_Exception_ObjectNotFound"}`))
+			}
+
+			virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
+			err := client.DoHttpRequestWithContext(context.Background(), "SoftLayer_Virtual_Guest/1234567/getObject.json", nil, "GET", new(bytes.Buffer), &virtualGuest)
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+			Expect(virtualGuest.Id).To(Equal(0))
+		})
+	})
+
+	Context("#Invoke", func() {
+		var (
+			server  *httptest.Server
+			handler http.HandlerFunc
+
+			requests      []*http.Request
+			requestBodies []string
+		)
+
+		BeforeEach(func() {
+			requests = []*http.Request{}
+			requestBodies = []string{}
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id": 1234, "networkIdentifier": "10.0.0.0", "cidr": 26}`))
+			}
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				requests = append(requests, r)
+				requestBodies = append(requestBodies, string(body))
+				handler(w, r)
+			}))
+
+			client = slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("calls a method of a service without typed support", func() {
+			subnet := map[string]interface{}{}
+			err := client.Invoke(context.Background(), softlayer.Invocation{
+				Service: "SoftLayer_Network_Subnet",
+				Id:      1234,
+				Method:  "getObject",
+				Options: &softlayer.RequestOptions{
+					Mask: softlayer.NewObjectMask("id", "networkIdentifier", "cidr"),
+				},
+			}, &subnet)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(subnet["networkIdentifier"]).To(Equal("10.0.0.0"))
+			Expect(requests[0].Method).To(Equal("GET"))
+			Expect(requests[0].URL.Path).To(Equal("/SoftLayer_Network_Subnet/1234/getObject.json"))
+			Expect(requests[0].URL.Query().Get("objectMask")).To(Equal("mask[id,networkIdentifier,cidr]"))
+		})
+
+		It("posts the positional parameters", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`true`))
+			}
+
+			var result bool
+			err := client.Invoke(context.Background(), softlayer.Invocation{
+				Service:    "SoftLayer_Virtual_Guest",
+				Id:         1234567,
+				Method:     "setTags",
+				Parameters: []interface{}{"prod,web"},
+			}, &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(BeTrue())
+			Expect(requests[0].Method).To(Equal("POST"))
+			Expect(requests[0].URL.Path).To(Equal("/SoftLayer_Virtual_Guest/1234567/setTags.json"))
+			Expect(requestBodies[0]).To(MatchJSON(`{"parameters": ["prod,web"]}`))
+		})
+
+		It("sends the filter and result limit of a call without init parameter", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`[]`))
+			}
+
+			options := &softlayer.RequestOptions{
+				Filter:      softlayer.NewObjectFilter().Set("subnets.cidr", softlayer.Equals(26)),
+				ResultLimit: &softlayer.ResultLimit{Offset: 0, Limit: 10},
+			}
+
+			subnets := []map[string]interface{}{}
+			err := client.Invoke(context.Background(), softlayer.Invocation{
+				Service: "SoftLayer_Account",
+				Method:  "getSubnets",
+				Options: options,
+			}, &subnets)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(requests[0].URL.Path).To(Equal("/SoftLayer_Account/getSubnets.json"))
+			Expect(requests[0].URL.Query().Get("objectFilter")).To(Equal(`{"subnets":{"cidr":{"operation":26}}}`))
+			Expect(requests[0].URL.Query().Get("resultLimit")).To(Equal("0,10"))
+		})
+
+		It("returns the SoftLayer errors of the call", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error": "Unable to find object with id of '1234'.", "code": "SoftLayer_Exception_ObjectNotFound"}`))
+			}
+
+			err := client.Invoke(context.Background(), softlayer.Invocation{
+				Service: "SoftLayer_Network_Subnet",
+				Id:      1234,
+				Method:  "getObject",
+			}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slErr.Service).To(Equal("SoftLayer_Network_Subnet"))
+			Expect(slErr.Method).To(Equal("getObject"))
+		})
+
+		It("fails without a method", func() {
+			err := client.Invoke(context.Background(), softlayer.Invocation{Service: "SoftLayer_Network_Subnet"}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(requests).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,281 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// xmlRpcValue is a value of an XML-RPC message. Only one of its fields is set,
+// or none for a string sent without a type.
+type xmlRpcValue struct {
+	Int      *string       `xml:"int"`
+	I4       *string       `xml:"i4"`
+	I8       *string       `xml:"i8"`
+	Double   *string       `xml:"double"`
+	Boolean  *string       `xml:"boolean"`
+	String   *string       `xml:"string"`
+	DateTime *string       `xml:"dateTime.iso8601"`
+	Base64   *string       `xml:"base64"`
+	Struct   *xmlRpcStruct `xml:"struct"`
+	Array    *xmlRpcArray  `xml:"array"`
+	Nil      *struct{}     `xml:"nil"`
+	Text     string        `xml:",chardata"`
+}
+
+type xmlRpcStruct struct {
+	Members []xmlRpcMember `xml:"member"`
+}
+
+type xmlRpcMember struct {
+	Name  string      `xml:"name"`
+	Value xmlRpcValue `xml:"value"`
+}
+
+type xmlRpcArray struct {
+	Values []xmlRpcValue `xml:"data>value"`
+}
+
+type xmlRpcMethodResponse struct {
+	Params []xmlRpcValue `xml:"params>param>value"`
+	Fault  *xmlRpcValue  `xml:"fault>value"`
+}
+
+type xmlRpcFault struct {
+	Code   string
+	String string
+}
+
+// encodeXmlRpcCall returns the methodCall of method with params. The params
+// are the values produced by encoding/json: maps, slices, strings, bools,
+// json.Number or float64 numbers and nil, or anything json.Marshal accepts.
+func encodeXmlRpcCall(method string, params []interface{}) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	buffer.WriteString(xml.Header)
+	buffer.WriteString("<methodCall><methodName>")
+	xml.EscapeText(buffer, []byte(method))
+	buffer.WriteString("</methodName><params>")
+
+	for _, param := range params {
+		buffer.WriteString("<param>")
+		err := encodeXmlRpcValue(buffer, param)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString("</param>")
+	}
+
+	buffer.WriteString("</params></methodCall>")
+
+	return buffer.Bytes(), nil
+}
+
+// decodeXmlRpcResponse returns the value of a methodResponse, as the types
+// produced by encoding/json, or its fault.
+func decodeXmlRpcResponse(reader io.Reader) (interface{}, *xmlRpcFault, error) {
+	response := xmlRpcMethodResponse{}
+	decoder := xml.NewDecoder(reader)
+	decoder.CharsetReader = xmlRpcCharsetReader
+	err := decoder.Decode(&response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if response.Fault != nil {
+		value, err := decodeXmlRpcValue(*response.Fault)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		members, _ := value.(map[string]interface{})
+		return nil, &xmlRpcFault{
+			Code:   fmt.Sprintf("%v", members["faultCode"]),
+			String: fmt.Sprintf("%v", members["faultString"]),
+		}, nil
+	}
+
+	if len(response.Params) == 0 {
+		return nil, nil, nil
+	}
+
+	value, err := decodeXmlRpcValue(response.Params[0])
+	return value, nil, err
+}
+
+//Private functions
+
+func encodeXmlRpcValue(buffer *bytes.Buffer, value interface{}) error {
+	switch value.(type) {
+	case nil, string, bool, int, json.Number, float64, []interface{}, map[string]interface{}:
+	default:
+		// Any other value, e.g. a datatypes struct, goes through its JSON
+		// form so that the json tags name the members.
+		jsonValue, err := toJsonValue(value)
+		if err != nil {
+			return err
+		}
+		value = jsonValue
+	}
+
+	buffer.WriteString("<value>")
+
+	switch typedValue := value.(type) {
+	case nil:
+		buffer.WriteString("<nil/>")
+	case string:
+		buffer.WriteString("<string>")
+		xml.EscapeText(buffer, []byte(typedValue))
+		buffer.WriteString("</string>")
+	case bool:
+		if typedValue {
+			buffer.WriteString("<boolean>1</boolean>")
+		} else {
+			buffer.WriteString("<boolean>0</boolean>")
+		}
+	case int:
+		fmt.Fprintf(buffer, "<int>%d</int>", typedValue)
+	case json.Number:
+		if _, err := typedValue.Int64(); err == nil {
+			fmt.Fprintf(buffer, "<int>%s</int>", typedValue)
+		} else {
+			fmt.Fprintf(buffer, "<double>%s</double>", typedValue)
+		}
+	case float64:
+		if typedValue == float64(int64(typedValue)) {
+			fmt.Fprintf(buffer, "<int>%d</int>", int64(typedValue))
+		} else {
+			buffer.WriteString("<double>" + strconv.FormatFloat(typedValue, 'f', -1, 64) + "</double>")
+		}
+	case []interface{}:
+		buffer.WriteString("<array><data>")
+		for _, element := range typedValue {
+			err := encodeXmlRpcValue(buffer, element)
+			if err != nil {
+				return err
+			}
+		}
+		buffer.WriteString("</data></array>")
+	case map[string]interface{}:
+		names := []string{}
+		for name := range typedValue {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		buffer.WriteString("<struct>")
+		for _, name := range names {
+			buffer.WriteString("<member><name>")
+			xml.EscapeText(buffer, []byte(name))
+			buffer.WriteString("</name>")
+			err := encodeXmlRpcValue(buffer, typedValue[name])
+			if err != nil {
+				return err
+			}
+			buffer.WriteString("</member>")
+		}
+		buffer.WriteString("</struct>")
+	}
+
+	buffer.WriteString("</value>")
+
+	return nil
+}
+
+func decodeXmlRpcValue(value xmlRpcValue) (interface{}, error) {
+	switch {
+	case value.Nil != nil:
+		return nil, nil
+	case value.String != nil:
+		return *value.String, nil
+	case value.Int != nil, value.I4 != nil, value.I8 != nil:
+		text := firstNonNil(value.Int, value.I4, value.I8)
+		return json.Number(strings.TrimSpace(text)), nil
+	case value.Double != nil:
+		return json.Number(strings.TrimSpace(*value.Double)), nil
+	case value.Boolean != nil:
+		return strings.TrimSpace(*value.Boolean) == "1", nil
+	case value.DateTime != nil:
+		return strings.TrimSpace(*value.DateTime), nil
+	case value.Base64 != nil:
+		return base64.StdEncoding.DecodeString(strings.TrimSpace(*value.Base64))
+	case value.Struct != nil:
+		members := map[string]interface{}{}
+		for _, member := range value.Struct.Members {
+			memberValue, err := decodeXmlRpcValue(member.Value)
+			if err != nil {
+				return nil, err
+			}
+			members[member.Name] = memberValue
+		}
+		return members, nil
+	case value.Array != nil:
+		elements := []interface{}{}
+		for _, element := range value.Array.Values {
+			elementValue, err := decodeXmlRpcValue(element)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, elementValue)
+		}
+		return elements, nil
+	}
+
+	return value.Text, nil
+}
+
+// xmlRpcCharsetReader reads the ISO-8859-1 responses sent by the SoftLayer
+// XML-RPC API as UTF-8.
+func xmlRpcCharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "utf-8", "us-ascii":
+		return input, nil
+	case "iso-8859-1", "latin1":
+		latin1, err := ioutil.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+
+		runes := make([]rune, len(latin1))
+		for i, b := range latin1 {
+			runes[i] = rune(b)
+		}
+
+		return strings.NewReader(string(runes)), nil
+	}
+
+	return nil, fmt.Errorf("unsupported charset %s", charset)
+}
+
+func toJsonValue(value interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonValue interface{}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	err = decoder.Decode(&jsonValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonValue, nil
+}
+
+func firstNonNil(texts ...*string) string {
+	for _, text := range texts {
+		if text != nil {
+			return *text
+		}
+	}
+
+	return ""
+}
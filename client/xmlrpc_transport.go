@@ -0,0 +1,195 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// xmlRpcTransport sends the requests to the XML-RPC API. The credentials,
+// init parameter, object mask, object filter and result limit are sent in the
+// headers struct passed as first parameter of each call, and the responses
+// are converted to JSON so that they decode into the same datatypes.
+type xmlRpcTransport struct {
+	client *softLayerClient
+}
+
+func (t *xmlRpcTransport) do(ctx context.Context, r request, result interface{}, attempt int) ([]byte, error) {
+	slc := t.client
+
+	fields := LogFields{
+		"service": r.service,
+		"method":  r.method,
+		"path":    r.path,
+		"verb":    r.requestType,
+		"attempt": attempt,
+	}
+
+	requestBody, err := t.methodCall(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", slc.endpoint+"/"+r.service, bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "text/xml")
+	req.Header.Set("User-Agent", slc.userAgent)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	if slc.logBodies {
+		fields["request_headers"] = redactHeader(req.Header)
+		fields["request_body"] = t.client.redact(string(requestBody))
+	}
+
+	start := time.Now()
+	resp, err := slc.httpClient.Do(req)
+	if err != nil {
+		fields["duration"] = time.Since(start)
+		fields["error"] = slc.redact(err.Error())
+		slc.logger.Log(LOG_LEVEL_WARN, "softlayer-go request", fields)
+
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	body := &countingReader{reader: resp.Body}
+	var reader io.Reader = body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+
+		reader = gzipReader
+	}
+
+	responseBody, err := ioutil.ReadAll(reader)
+
+	fields["duration"] = time.Since(start)
+	fields["status"] = resp.StatusCode
+	fields["bytes"] = body.count
+	if slc.logBodies {
+		fields["response_body"] = t.client.redact(string(responseBody))
+	}
+
+	if err != nil {
+		fields["error"] = slc.redact(err.Error())
+		slc.logger.Log(LOG_LEVEL_WARN, "softlayer-go request", fields)
+
+		return nil, err
+	}
+
+	value, fault, decodeErr := decodeXmlRpcResponse(bytes.NewReader(responseBody))
+
+	switch {
+	case fault != nil:
+		slc.logger.Log(LOG_LEVEL_WARN, "softlayer-go request", fields)
+
+		return nil, &softlayer.SoftLayerError{
+			StatusCode: resp.StatusCode,
+			Code:       fault.Code,
+			Message:    fault.String,
+			Service:    r.service,
+			Method:     r.method,
+			Path:       r.path,
+			Body:       responseBody,
+		}
+	case resp.StatusCode >= http.StatusBadRequest:
+		slc.logger.Log(LOG_LEVEL_WARN, "softlayer-go request", fields)
+
+		return nil, newSoftLayerError(r.path, r.requestType, resp.StatusCode, responseBody)
+	case decodeErr != nil:
+		fields["error"] = decodeErr.Error()
+		slc.logger.Log(LOG_LEVEL_WARN, "softlayer-go request", fields)
+
+		return nil, newInvalidXmlRpcResponseError(r, resp.StatusCode, responseBody, decodeErr)
+	}
+
+	slc.logger.Log(LOG_LEVEL_INFO, "softlayer-go request", fields)
+
+	if totalItems, err := strconv.Atoi(resp.Header.Get("SoftLayer-Total-Items")); err == nil && r.totalItems != nil {
+		*r.totalItems = totalItems
+	}
+
+	jsonBody, err := json.Marshal(value)
+	if err != nil {
+		return nil, newInvalidXmlRpcResponseError(r, resp.StatusCode, responseBody, err)
+	}
+
+	if result == nil {
+		return jsonBody, nil
+	}
+
+	err = json.Unmarshal(jsonBody, result)
+	if err != nil {
+		return nil, newInvalidJsonResponseError(r, resp.StatusCode, jsonBody, err)
+	}
+
+	return nil, nil
+}
+
+// methodCall returns the XML-RPC call of r, with the headers struct followed
+// by the parameters of its path and of its JSON body.
+func (t *xmlRpcTransport) methodCall(r request) ([]byte, error) {
+	headers := map[string]interface{}{
+		"authenticate": map[string]interface{}{
+			"username": t.client.username,
+			"apiKey":   t.client.apiKey,
+		},
+	}
+
+	if id := r.initParameter(); id != 0 {
+		headers[r.service+"InitParameters"] = map[string]interface{}{"id": id}
+	}
+
+	if !r.options.Mask.IsEmpty() {
+		headers["SoftLayer_ObjectMask"] = map[string]interface{}{"mask": r.options.Mask.String()}
+	}
+
+	if !r.options.Filter.IsEmpty() {
+		var filter interface{}
+		decoder := json.NewDecoder(strings.NewReader(r.options.Filter.String()))
+		decoder.UseNumber()
+		err := decoder.Decode(&filter)
+		if err != nil {
+			return nil, err
+		}
+
+		headers[r.service+"ObjectFilter"] = filter
+	}
+
+	if r.options.ResultLimit != nil {
+		headers["resultLimit"] = map[string]interface{}{
+			"offset": r.options.ResultLimit.Offset,
+			"limit":  r.options.ResultLimit.Limit,
+		}
+	}
+
+	parameters, err := r.parameters()
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeXmlRpcCall(r.method, append([]interface{}{headers}, parameters...))
+}
+
+func newInvalidXmlRpcResponseError(r request, statusCode int, responseBody []byte, err error) *softlayer.SoftLayerError {
+	slErr := newInvalidJsonResponseError(r, statusCode, responseBody, err)
+	slErr.Message = "failed to decode XML-RPC response, err message '" + err.Error() + "'"
+
+	return slErr
+}
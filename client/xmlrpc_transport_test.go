@@ -0,0 +1,305 @@
+package client_test
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// xmlRpcCall is a call received by the XML-RPC stand-in server, with its
+// headers struct split from its parameters.
+type xmlRpcCall struct {
+	Service    string
+	Method     string
+	Headers    map[string]interface{}
+	Parameters []interface{}
+}
+
+type standInMethodCall struct {
+	MethodName string         `xml:"methodName"`
+	Params     []standInValue `xml:"params>param>value"`
+}
+
+type standInValue struct {
+	Int     *string `xml:"int"`
+	Double  *string `xml:"double"`
+	Boolean *string `xml:"boolean"`
+	String  *string `xml:"string"`
+	Struct  *struct {
+		Members []struct {
+			Name  string       `xml:"name"`
+			Value standInValue `xml:"value"`
+		} `xml:"member"`
+	} `xml:"struct"`
+	Array *struct {
+		Values []standInValue `xml:"data>value"`
+	} `xml:"array"`
+	Nil *struct{} `xml:"nil"`
+}
+
+func (v standInValue) decode() interface{} {
+	switch {
+	case v.Int != nil:
+		var i int
+		fmt.Sscanf(*v.Int, "%d", &i)
+		return i
+	case v.Double != nil:
+		var f float64
+		fmt.Sscanf(*v.Double, "%g", &f)
+		return f
+	case v.Boolean != nil:
+		return *v.Boolean == "1"
+	case v.String != nil:
+		return *v.String
+	case v.Struct != nil:
+		members := map[string]interface{}{}
+		for _, member := range v.Struct.Members {
+			members[member.Name] = member.Value.decode()
+		}
+		return members
+	case v.Array != nil:
+		values := []interface{}{}
+		for _, value := range v.Array.Values {
+			values = append(values, value.decode())
+		}
+		return values
+	}
+
+	return nil
+}
+
+func xmlRpcResponse(value string) string {
+	return `<?xml version="1.0" encoding="iso-8859-1"?><methodResponse><params><param><value>` + value + `</value></param></params></methodResponse>`
+}
+
+func xmlRpcFault(code string, message string) string {
+	return `<?xml version="1.0" encoding="iso-8859-1"?><methodResponse><fault><value><struct>` +
+		`<member><name>faultCode</name><value><string>` + code + `</string></value></member>` +
+		`<member><name>faultString</name><value><string>` + message + `</string></value></member>` +
+		`</struct></value></fault></methodResponse>`
+}
+
+var _ = Describe("XML-RPC transport", func() {
+	var (
+		server  *httptest.Server
+		handler func(call xmlRpcCall) string
+
+		calls []xmlRpcCall
+
+		client softlayer.Client
+	)
+
+	BeforeEach(func() {
+		calls = []xmlRpcCall{}
+		handler = func(call xmlRpcCall) string {
+			return xmlRpcResponse("<boolean>1</boolean>")
+		}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(r.Method).To(Equal("POST"))
+			Expect(r.Header.Get("Content-Type")).To(Equal("text/xml"))
+
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+
+			methodCall := standInMethodCall{}
+			err = xml.Unmarshal(body, &methodCall)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(methodCall.Params).ToNot(BeEmpty())
+
+			call := xmlRpcCall{
+				Service: strings.TrimPrefix(r.URL.Path, "/"),
+				Method:  methodCall.MethodName,
+				Headers: methodCall.Params[0].decode().(map[string]interface{}),
+			}
+			for _, param := range methodCall.Params[1:] {
+				call.Parameters = append(call.Parameters, param.decode())
+			}
+			calls = append(calls, call)
+
+			authenticate, _ := call.Headers["authenticate"].(map[string]interface{})
+			if authenticate["username"] != "fake-username" || authenticate["apiKey"] != "fake-api-key" {
+				w.Write([]byte(xmlRpcFault(softlayer.SOFTLAYER_EXCEPTION_INVALID_CREDENTIALS, "Invalid API token.")))
+				return
+			}
+
+			w.Header().Set("Content-Type", "text/xml")
+			w.Write([]byte(handler(call)))
+		}))
+
+		client = slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithXmlRpc(server.URL))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("calls the services with the init parameter and object mask in the headers", func() {
+		handler = func(call xmlRpcCall) string {
+			return xmlRpcResponse(`<struct>` +
+				`<member><name>id</name><value><int>1234567</int></value></member>` +
+				`<member><name>hostname</name><value><string>fake-hostname</string></value></member>` +
+				`<member><name>maxMemory</name><value><int>1024</int></value></member>` +
+				`<member><name>dedicatedAccountHostOnlyFlag</name><value><boolean>0</boolean></value></member>` +
+				`</struct>`)
+		}
+
+		virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		virtualGuest, err := virtualGuestService.GetObject(1234567)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(virtualGuest.Id).To(Equal(1234567))
+		Expect(virtualGuest.Hostname).To(Equal("fake-hostname"))
+		Expect(virtualGuest.MaxMemory).To(Equal(1024))
+
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].Service).To(Equal("SoftLayer_Virtual_Guest"))
+		Expect(calls[0].Method).To(Equal("getObject"))
+		Expect(calls[0].Headers["SoftLayer_Virtual_GuestInitParameters"]).To(Equal(map[string]interface{}{"id": 1234567}))
+		Expect(calls[0].Headers).To(HaveKey("SoftLayer_ObjectMask"))
+		Expect(calls[0].Headers["SoftLayer_ObjectMask"].(map[string]interface{})["mask"]).To(ContainSubstring("operatingSystem[passwords[password,username]]"))
+		Expect(calls[0].Parameters).To(BeEmpty())
+	})
+
+	It("sends the parameters of the JSON body", func() {
+		virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		tagged, err := virtualGuestService.SetTags(1234567, []string{"prod", "web"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tagged).To(BeTrue())
+
+		Expect(calls[0].Method).To(Equal("setTags"))
+		Expect(calls[0].Parameters).To(Equal([]interface{}{"prod, web"}))
+	})
+
+	It("sends the parameters of the path after the method", func() {
+		virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		available, err := virtualGuestService.CheckHostDiskAvailability(1234567, 25)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(available).To(BeTrue())
+
+		Expect(calls[0].Method).To(Equal("checkHostDiskAvailability"))
+		Expect(calls[0].Headers["SoftLayer_Virtual_GuestInitParameters"]).To(Equal(map[string]interface{}{"id": 1234567}))
+		Expect(calls[0].Parameters).To(Equal([]interface{}{25}))
+	})
+
+	It("keeps the complex type of order containers", func() {
+		handler = func(call xmlRpcCall) string {
+			return xmlRpcResponse(`<struct><member><name>orderId</name><value><int>123</int></value></member></struct>`)
+		}
+
+		productOrderService, err := client.GetSoftLayer_Product_Order_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		receipt, err := productOrderService.PlaceOrder(datatypes.SoftLayer_Product_Order{
+			ComplexType: "SoftLayer_Container_Product_Order_Network_Storage_Iscsi",
+			Location:    "dal09",
+			PackageId:   0,
+			Prices:      []datatypes.SoftLayer_Item_Price{{Id: 2222}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receipt.OrderId).To(Equal(123))
+
+		Expect(calls[0].Service).To(Equal("SoftLayer_Product_Order"))
+		Expect(calls[0].Method).To(Equal("placeOrder"))
+		Expect(calls[0].Headers).ToNot(HaveKey("SoftLayer_Product_OrderInitParameters"))
+
+		order := calls[0].Parameters[0].(map[string]interface{})
+		Expect(order["complexType"]).To(Equal("SoftLayer_Container_Product_Order_Network_Storage_Iscsi"))
+		Expect(order["location"]).To(Equal("dal09"))
+		Expect(order["prices"]).To(Equal([]interface{}{map[string]interface{}{"id": 2222}}))
+	})
+
+	It("sends the object filter and result limit in the headers", func() {
+		handler = func(call xmlRpcCall) string {
+			return xmlRpcResponse(`<array><data><value><struct><member><name>id</name><value><int>1</int></value></member></struct></value></data></array>`)
+		}
+
+		invocation := softlayer.Invocation{
+			Service: "SoftLayer_Account",
+			Method:  "getVirtualGuests",
+			Options: &softlayer.RequestOptions{
+				Filter:      softlayer.NewObjectFilter().Set("virtualGuests.datacenter.name", softlayer.Equals("dal09")),
+				ResultLimit: &softlayer.ResultLimit{Offset: 50, Limit: 25},
+			},
+		}
+
+		virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
+		err := client.Invoke(context.Background(), invocation, &virtualGuests)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(virtualGuests).To(HaveLen(1))
+
+		Expect(calls[0].Headers["SoftLayer_AccountObjectFilter"]).To(Equal(map[string]interface{}{
+			"virtualGuests": map[string]interface{}{
+				"datacenter": map[string]interface{}{
+					"name": map[string]interface{}{"operation": "dal09"},
+				},
+			},
+		}))
+		Expect(calls[0].Headers["resultLimit"]).To(Equal(map[string]interface{}{"offset": 50, "limit": 25}))
+	})
+
+	It("returns the faults as SoftLayerErrors", func() {
+		handler = func(call xmlRpcCall) string {
+			return xmlRpcFault(softlayer.SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND, "Unable to find object with id of '1234567'.")
+		}
+
+		virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = virtualGuestService.GetObject(1234567)
+		Expect(err).To(HaveOccurred())
+		Expect(softlayer.IsNotFound(err)).To(BeTrue())
+
+		slErr, ok := softlayer.AsSoftLayerError(err)
+		Expect(ok).To(BeTrue())
+		Expect(slErr.Service).To(Equal("SoftLayer_Virtual_Guest"))
+		Expect(slErr.Method).To(Equal("getObject"))
+		Expect(slErr.Message).To(Equal("Unable to find object with id of '1234567'."))
+		Expect(slErr.Attempts).To(Equal(1))
+	})
+
+	It("authenticates with the client credentials", func() {
+		client = slclient.NewSoftLayerClient("fake-username", "wrong-api-key", slclient.WithXmlRpc(server.URL))
+
+		accountService, err := client.GetSoftLayer_Account_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = accountService.GetAccountStatus()
+		Expect(err).To(HaveOccurred())
+		Expect(softlayer.IsAuthError(err)).To(BeTrue())
+	})
+
+	It("redacts the API key from the logged request bodies", func() {
+		logger := &recordingLogger{}
+		client = slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithXmlRpc(server.URL), slclient.WithLogger(logger), slclient.WithLogBodies(true))
+
+		virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = virtualGuestService.SetTags(1234567, []string{"prod"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(logger.entries).ToNot(BeEmpty())
+		requestBody := logger.entries[0].fields["request_body"].(string)
+		Expect(requestBody).To(ContainSubstring("<name>apiKey</name><value>[REDACTED]</value>"))
+		Expect(requestBody).ToNot(ContainSubstring("fake-api-key"))
+	})
+})
@@ -0,0 +1,247 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// Checker checks that JSON fixtures match the types they are decoded into,
+// and remembers which fields of these types the fixtures populate.
+type Checker struct {
+	populated map[reflect.Type]map[string]bool
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		populated: map[reflect.Type]map[string]bool{},
+	}
+}
+
+// CheckFixture decodes fixture into target, a pointer, failing on the fields
+// unknown to its type. As encoding/json matches the field names regardless of
+// case, it also fails on the fields only matching by case, e.g. "id" for a
+// field tagged "Id".
+func (c *Checker) CheckFixture(fixture []byte, target interface{}) error {
+	targetType := reflect.TypeOf(target)
+	if targetType == nil || targetType.Kind() != reflect.Ptr {
+		return errors.New("contracts: the target of a fixture must be a pointer")
+	}
+
+	var value interface{}
+	err := json.Unmarshal(fixture, &value)
+	if err != nil {
+		return err
+	}
+
+	problems := c.checkValue(value, targetType.Elem(), "")
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "\n"))
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(fixture))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(target)
+}
+
+// UnpopulatedFields returns the fields, as Type.Field, of the types checked
+// so far that no fixture populated.
+func (c *Checker) UnpopulatedFields() []string {
+	unpopulated := []string{}
+	for structType, populated := range c.populated {
+		for _, field := range jsonFields(structType) {
+			if !populated[field.goName] {
+				unpopulated = append(unpopulated, structType.Name()+"."+field.goName)
+			}
+		}
+	}
+	sort.Strings(unpopulated)
+
+	return unpopulated
+}
+
+// CheckObjectMask fails on the properties of mask that do not name a field of
+// target, e.g. a datatypes.SoftLayer_Virtual_Guest, or of its relational
+// properties.
+func CheckObjectMask(mask *softlayer.ObjectMask, target interface{}) error {
+	problems := []string{}
+	for _, property := range mask.Properties() {
+		structType := reflect.TypeOf(target)
+		for _, name := range strings.Split(property, ".") {
+			structType = elemType(structType)
+			if structType.Kind() != reflect.Struct {
+				problems = append(problems, fmt.Sprintf("%s: %s has no properties", property, structType))
+				break
+			}
+
+			field, ok := jsonFields(structType)[name]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s: %s", property, unknownField(structType, name)))
+				break
+			}
+
+			structType = field.fieldType
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
+
+//Private methods
+
+func (c *Checker) checkValue(value interface{}, valueType reflect.Type, path string) []string {
+	valueType = elemPointer(valueType)
+	if valueType == timeType || reflect.PtrTo(valueType).Implements(unmarshalerType) {
+		return []string{}
+	}
+
+	problems := []string{}
+	switch valueType.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return problems
+		}
+
+		populated, ok := c.populated[valueType]
+		if !ok {
+			populated = map[string]bool{}
+			c.populated[valueType] = populated
+		}
+
+		fields := jsonFields(valueType)
+		for _, name := range sortedKeys(object) {
+			field, ok := fields[name]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s%s: %s", path, name, unknownField(valueType, name)))
+				continue
+			}
+
+			if object[name] != nil {
+				populated[field.goName] = true
+			}
+
+			problems = append(problems, c.checkValue(object[name], field.fieldType, path+name+".")...)
+		}
+	case reflect.Slice, reflect.Array:
+		elements, ok := value.([]interface{})
+		if !ok {
+			return problems
+		}
+
+		for i, element := range elements {
+			problems = append(problems, c.checkValue(element, valueType.Elem(), fmt.Sprintf("%s%d.", path, i))...)
+		}
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return problems
+		}
+
+		for _, key := range sortedKeys(object) {
+			problems = append(problems, c.checkValue(object[key], valueType.Elem(), path+key+".")...)
+		}
+	}
+
+	return problems
+}
+
+//Private functions
+
+type jsonField struct {
+	goName    string
+	fieldType reflect.Type
+}
+
+// jsonFields returns the fields of a struct by their JSON name, including the
+// ones of its embedded structs.
+func jsonFields(structType reflect.Type) map[string]jsonField {
+	fields := map[string]jsonField{}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" && elemPointer(field.Type).Kind() == reflect.Struct {
+			for embeddedName, embeddedField := range jsonFields(elemPointer(field.Type)) {
+				if _, ok := fields[embeddedName]; !ok {
+					fields[embeddedName] = embeddedField
+				}
+			}
+			continue
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = jsonField{goName: field.Name, fieldType: field.Type}
+	}
+
+	return fields
+}
+
+func unknownField(structType reflect.Type, name string) string {
+	for fieldName, field := range jsonFields(structType) {
+		if strings.EqualFold(fieldName, name) {
+			return fmt.Sprintf("%s.%s is named \"%s\", not \"%s\"", structType.Name(), field.goName, fieldName, name)
+		}
+	}
+
+	return fmt.Sprintf("%s has no field \"%s\"", structType.Name(), name)
+}
+
+func elemPointer(valueType reflect.Type) reflect.Type {
+	for valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+
+	return valueType
+}
+
+// elemType returns the type of the objects of a relational property, e.g.
+// SoftLayer_Network_Vlan for []*SoftLayer_Network_Vlan.
+func elemType(valueType reflect.Type) reflect.Type {
+	for {
+		switch valueType.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			valueType = valueType.Elem()
+		default:
+			return valueType
+		}
+	}
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := []string{}
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,114 @@
+package contracts_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	contracts "github.com/maximilien/softlayer-go/common/contracts"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type fakeLocation struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type fakeEntity struct {
+	Id int `json:"id"`
+}
+
+type fakeGuest struct {
+	fakeEntity
+
+	Hostname   string          `json:"hostname"`
+	Notes      string          `json:"notes,omitempty"`
+	VlanNumber int             `json:"VlanNumber"`
+	CreateDate *time.Time      `json:"createDate"`
+	Location   *fakeLocation   `json:"location"`
+	Locations  []*fakeLocation `json:"locations"`
+	Ignored    string          `json:"-"`
+}
+
+var _ = Describe("Contracts", func() {
+	var checker *contracts.Checker
+
+	BeforeEach(func() {
+		checker = contracts.NewChecker()
+	})
+
+	Context("#CheckFixture", func() {
+		It("decodes a fixture matching its type", func() {
+			guest := fakeGuest{}
+			err := checker.CheckFixture([]byte(`{"id":1,"hostname":"fake-hostname","createDate":"2014-08-12T12:13:01-08:00","location":{"id":2}}`), &guest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(guest.Id).To(Equal(1))
+			Expect(guest.Location.Id).To(Equal(2))
+		})
+
+		It("checks the elements of a list", func() {
+			guests := []fakeGuest{}
+			err := checker.CheckFixture([]byte(`[{"id":1},{"id":2,"locations":[{"id":3,"longName":"fake-name"}]}]`), &guests)
+			Expect(err).To(MatchError(`1.locations.0.longName: fakeLocation has no field "longName"`))
+		})
+
+		It("fails on the fields only matching by case", func() {
+			err := checker.CheckFixture([]byte(`{"vlanNumber":809}`), &fakeGuest{})
+			Expect(err).To(MatchError(`vlanNumber: fakeGuest.VlanNumber is named "VlanNumber", not "vlanNumber"`))
+		})
+
+		It("fails on the ignored fields", func() {
+			err := checker.CheckFixture([]byte(`{"Ignored":"fake-value"}`), &fakeGuest{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails on the values of the wrong type", func() {
+			err := checker.CheckFixture([]byte(`{"hostname":1}`), &fakeGuest{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails on a fixture that is not JSON", func() {
+			var keyName string
+			err := checker.CheckFixture([]byte(`SYSTEM`), &keyName)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails on a target that is not a pointer", func() {
+			err := checker.CheckFixture([]byte(`{}`), fakeGuest{})
+			Expect(err).To(MatchError("contracts: the target of a fixture must be a pointer"))
+		})
+	})
+
+	Context("#UnpopulatedFields", func() {
+		It("returns the fields no fixture populated", func() {
+			err := checker.CheckFixture([]byte(`{"id":1,"hostname":"fake-hostname","notes":null,"location":{"id":2}}`), &fakeGuest{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = checker.CheckFixture([]byte(`{"locations":[{"name":"fake-name"}]}`), &fakeGuest{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(checker.UnpopulatedFields()).To(Equal([]string{
+				"fakeGuest.CreateDate",
+				"fakeGuest.Notes",
+				"fakeGuest.VlanNumber",
+			}))
+		})
+	})
+
+	Context("#CheckObjectMask", func() {
+		It("accepts the masks of fields and relational properties", func() {
+			mask := softlayer.NewObjectMask("id", "hostname", "location.name", "locations.id")
+			Expect(contracts.CheckObjectMask(mask, fakeGuest{})).ToNot(HaveOccurred())
+		})
+
+		It("fails on the properties unknown to the type", func() {
+			mask := softlayer.NewObjectMask("id", "location.longName", "vlanNumber", "hostname.id")
+			err := contracts.CheckObjectMask(mask, []fakeGuest{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`location.longName: fakeLocation has no field "longName"`))
+			Expect(err.Error()).To(ContainSubstring(`vlanNumber: fakeGuest.VlanNumber is named "VlanNumber", not "vlanNumber"`))
+			Expect(err.Error()).To(ContainSubstring(`hostname.id: string has no properties`))
+		})
+	})
+})
@@ -0,0 +1,24 @@
+package data_types
+
+type SoftLayer_Container_Disk_Image_Capture_Template_Parameters struct {
+	Parameters []SoftLayer_Container_Disk_Image_Capture_Template `json:"parameters"`
+}
+
+type SoftLayer_Container_Disk_Image_Capture_Template struct {
+	Description string `json:"description,omitempty"`
+	Name        string `json:"name"`
+	Summary     string `json:"summary,omitempty"`
+
+	Volumes []SoftLayer_Container_Disk_Image_Capture_Template_Volume `json:"volumes,omitempty"`
+}
+
+type SoftLayer_Container_Disk_Image_Capture_Template_Volume struct {
+	Name string `json:"name"`
+
+	Partitions []SoftLayer_Container_Disk_Image_Capture_Template_Volume_Partition `json:"partitions,omitempty"`
+}
+
+type SoftLayer_Container_Disk_Image_Capture_Template_Volume_Partition struct {
+	IsRoot bool   `json:"isRoot"`
+	Name   string `json:"name"`
+}
@@ -0,0 +1,18 @@
+package data_types
+
+type SoftLayer_Container_Hardware_Server_Configuration_Parameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
+
+// SoftLayer_Container_Hardware_Server_Configuration is the configuration of
+// an operating system reload. The current operating system is reinstalled
+// when neither an image template nor an operating system item price is set.
+type SoftLayer_Container_Hardware_Server_Configuration struct {
+	ImageTemplateId int                    `json:"imageTemplateId,omitempty"`
+	ItemPrices      []SoftLayer_Item_Price `json:"itemPrices,omitempty"`
+
+	SshKeyIds []int `json:"sshKeyIds,omitempty"`
+
+	PostInstallScriptUri     string `json:"postInstallScriptUri,omitempty"`
+	CustomProvisionScriptUri string `json:"customProvisionScriptUri,omitempty"`
+}
@@ -20,14 +20,31 @@ type SoftLayer_Hardware_Template struct {
 }
 
 type SoftLayer_Hardware struct {
-	BareMetalInstanceFlag int        `json:"bareMetalInstanceFlag"`
-	Domain                string     `json:"domain"`
-	Hostname              string     `json:"hostname"`
-	Id                    int        `json:"id"`
-	HardwareStatusId      int        `json:"hardwareStatusId"`
-	ProvisionDate         *time.Time `json:"provisionDate"`
-	GlobalIdentifier      string     `json:"globalIdentifier"`
-	PrimaryIpAddress      string     `json:"primaryIpAddress"`
+	AccountId                  int        `json:"accountId"`
+	BareMetalInstanceFlag      int        `json:"bareMetalInstanceFlag"`
+	Domain                     string     `json:"domain"`
+	FullyQualifiedDomainName   string     `json:"fullyQualifiedDomainName"`
+	Hostname                   string     `json:"hostname"`
+	Id                         int        `json:"id"`
+	HardwareStatusId           int        `json:"hardwareStatusId"`
+	ManufacturerSerialNumber   string     `json:"manufacturerSerialNumber"`
+	Notes                      string     `json:"notes"`
+	ProvisionDate              *time.Time `json:"provisionDate"`
+	SerialNumber               string     `json:"serialNumber"`
+	ServiceProviderId          int        `json:"serviceProviderId"`
+	ServiceProviderResourceId  int        `json:"serviceProviderResourceId"`
+	GlobalIdentifier           string     `json:"globalIdentifier"`
+	NetworkManagementIpAddress string     `json:"networkManagementIpAddress"`
+	PrimaryBackendIpAddress    string     `json:"primaryBackendIpAddress"`
+	PrimaryIpAddress           string     `json:"primaryIpAddress"`
+	PrivateIpAddress           string     `json:"privateIpAddress"`
 
-	OperatingSystem *SoftLayer_Operating_System `json:"operatingSystem"`
+	HardwareFunction *SoftLayer_Hardware_Function `json:"hardwareFunction"`
+	OperatingSystem  *SoftLayer_Operating_System  `json:"operatingSystem"`
+}
+
+type SoftLayer_Hardware_Function struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Id          int    `json:"id"`
 }
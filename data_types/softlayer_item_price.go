@@ -1,17 +1,67 @@
 package data_types
 
 type SoftLayer_Item_Price struct {
-	Id         int        `json:"id"`
-	Categories []Category `json:"categories,omitempty"`
-	Item       *Item      `json:"item,omitempty"`
+	Id                 int        `json:"id"`
+	ItemId             int        `json:"itemId,omitempty"`
+	CurrentPriceFlag   *bool      `json:"currentPriceFlag,omitempty"`
+	OnSaleFlag         *bool      `json:"onSaleFlag,omitempty"`
+	Quantity           *int       `json:"quantity,omitempty"`
+	Sort               int        `json:"sort,omitempty"`
+	HourlyRecurringFee string     `json:"hourlyRecurringFee,omitempty"`
+	RecurringFee       string     `json:"recurringFee,omitempty"`
+	OneTimeFee         string     `json:"oneTimeFee,omitempty"`
+	SetupFee           string     `json:"setupFee,omitempty"`
+	LaborFee           string     `json:"laborFee,omitempty"`
+	Categories         []Category `json:"categories,omitempty"`
+	Item               *Item      `json:"item,omitempty"`
+
+	AccountRestrictions []Item_Price_Account_Restriction `json:"accountRestrictions,omitempty"`
+}
+
+type Item_Price_Account_Restriction struct {
+	Id          int `json:"id"`
+	AccountId   int `json:"accountId"`
+	ItemPriceId int `json:"itemPriceId"`
 }
 
 type Item struct {
-	Id          int    `json:"id"`
-	Description string `json:"description"`
-	Capacity    string `json:"capacity"`
+	Id                    int    `json:"id"`
+	Description           string `json:"description"`
+	Capacity              string `json:"capacity"`
+	KeyName               string `json:"keyName,omitempty"`
+	Units                 string `json:"units,omitempty"`
+	ItemTaxCategoryId     int    `json:"itemTaxCategoryId,omitempty"`
+	SoftwareDescriptionId *int   `json:"softwareDescriptionId,omitempty"`
+	UpgradeItemId         *int   `json:"upgradeItemId,omitempty"`
+
+	Attributes          []Item_Attribute           `json:"attributes,omitempty"`
+	SoftwareDescription *Item_Software_Description `json:"softwareDescription,omitempty"`
+}
+
+type Item_Software_Description struct {
+	Id            int    `json:"id"`
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	ReferenceCode string `json:"referenceCode"`
+}
+
+type Item_Attribute struct {
+	Id                  int    `json:"id"`
+	ItemAttributeTypeId int    `json:"itemAttributeTypeId"`
+	ItemId              int    `json:"itemId"`
+	Value               string `json:"value"`
+
+	AttributeType *Item_Attribute_Type `json:"attributeType,omitempty"`
+}
+
+type Item_Attribute_Type struct {
+	KeyName string `json:"keyName"`
+	Name    string `json:"name"`
 }
 
 type Category struct {
-	CategoryCode string `json:"categoryCode"`
+	Id            int    `json:"id,omitempty"`
+	CategoryCode  string `json:"categoryCode"`
+	Name          string `json:"name,omitempty"`
+	QuantityLimit int    `json:"quantityLimit,omitempty"`
 }
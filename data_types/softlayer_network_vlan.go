@@ -6,7 +6,7 @@ import (
 
 type SoftLayer_Network_Vlan struct {
 	AccountId       int        `json:"accountId"`
-	Id              int        `json:"Id"`
+	Id              int        `json:"id"`
 	ModifyDate      *time.Time `json:"modifyDate,omitempty"`
 	Name            string     `json:"name"`
 	NetworkVrfId    int        `json:"networkVrfId"`
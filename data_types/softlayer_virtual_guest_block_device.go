@@ -0,0 +1,26 @@
+package data_types
+
+import (
+	"time"
+)
+
+type SoftLayer_Virtual_Guest_Block_Device struct {
+	BootableFlag int        `json:"bootableFlag,omitempty"`
+	CreateDate   *time.Time `json:"createDate,omitempty"`
+	Device       string     `json:"device,omitempty"`
+	DiskImageId  int        `json:"diskImageId,omitempty"`
+	GuestId      int        `json:"guestId,omitempty"`
+	HotPlugFlag  int        `json:"hotPlugFlag,omitempty"`
+	Id           int        `json:"id"`
+	ModifyDate   *time.Time `json:"modifyDate,omitempty"`
+	MountMode    string     `json:"mountMode,omitempty"`
+	MountType    string     `json:"mountType,omitempty"`
+	StatusId     int        `json:"statusId,omitempty"`
+	Uuid         string     `json:"uuid,omitempty"`
+
+	DiskImage *SoftLayer_Virtual_Disk_Image `json:"diskImage,omitempty"`
+}
+
+type SoftLayer_Virtual_Guest_CreateArchiveTransaction_Parameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
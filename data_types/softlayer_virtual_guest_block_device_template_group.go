@@ -15,4 +15,14 @@ type SoftLayer_Virtual_Guest_Block_Device_Template_Group struct {
 	TransactionId    *int       `json:"transactionId"`
 	UserRecordId     int        `json:"userRecordId"`
 	GlobalIdentifier string     `json:"globalIdentifier"`
+
+	AccountReferences []SoftLayer_Virtual_Guest_Block_Device_Template_Group_Accounts `json:"accountReferences,omitempty"`
+}
+
+type SoftLayer_Virtual_Guest_Block_Device_Template_Group_Accounts struct {
+	AccountId  int        `json:"accountId"`
+	CreateDate *time.Time `json:"createDate"`
+	GroupId    int        `json:"groupId"`
+
+	Group *SoftLayer_Virtual_Guest_Block_Device_Template_Group `json:"group"`
 }
@@ -0,0 +1,342 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	DATATYPES_DIR     = "data_types"
+	SERVICES_DIR      = "services"
+	SERVICE_FAKES_DIR = "services/fakes"
+
+	SOFTLAYER_IMPORT_PATH = "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type Config struct {
+	// ImportPath is the import path of the output directory, under which the
+	// data_types, services and services/fakes packages are generated.
+	ImportPath string
+}
+
+// Generate returns the generated sources for metadata, formatted and indexed
+// by path relative to the output directory.
+func Generate(metadata Metadata, config Config) (map[string][]byte, error) {
+	files := map[string][]byte{}
+
+	for _, name := range sortedTypeNames(metadata) {
+		slType := metadata[name]
+		fileName := strings.ToLower(name) + ".go"
+
+		datatype, err := newDatatypeView(metadata, slType)
+		if err != nil {
+			return nil, err
+		}
+
+		err = render(files, filepath.Join(DATATYPES_DIR, fileName), datatypeTemplate, datatype)
+		if err != nil {
+			return nil, err
+		}
+
+		if slType.NoService || len(slType.Methods) == 0 {
+			continue
+		}
+
+		service, err := newServiceView(metadata, slType, config)
+		if err != nil {
+			return nil, err
+		}
+
+		err = render(files, filepath.Join(SERVICES_DIR, fileName), serviceTemplate, service)
+		if err != nil {
+			return nil, err
+		}
+
+		err = render(files, filepath.Join(SERVICE_FAKES_DIR, strings.ToLower(name)+"_fake.go"), serviceFakeTemplate, service)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+// WriteFiles writes the generated files under outputDir.
+func WriteFiles(outputDir string, files map[string][]byte) error {
+	for path, source := range files {
+		path = filepath.Join(outputDir, path)
+
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			return err
+		}
+
+		err = ioutil.WriteFile(path, source, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//Private types
+
+type datatypeView struct {
+	Name     string
+	Doc      string
+	Fields   []fieldView
+	UsesTime bool
+}
+
+type fieldView struct {
+	Name string
+	Type string
+	Tag  string
+	Doc  string
+}
+
+type serviceView struct {
+	Name     string
+	Doc      string
+	Receiver string
+	Methods  []methodView
+
+	DatatypesImportPath string
+	ServicesImportPath  string
+	SoftLayerImportPath string
+	UsesDatatypes       bool
+}
+
+type methodView struct {
+	Name       string
+	ApiName    string
+	Doc        string
+	Static     bool
+	Parameters []parameterView
+	ResultType string
+}
+
+type parameterView struct {
+	Name      string
+	FieldName string
+	Type      string
+}
+
+//Private functions
+
+func newDatatypeView(metadata Metadata, slType Type) (datatypeView, error) {
+	datatype := datatypeView{
+		Name: slType.Name,
+		Doc:  docComment(slType.TypeDoc),
+	}
+
+	names := []string{}
+	for name := range slType.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property := slType.Properties[name]
+
+		goType, err := goType(metadata, property.Type, property.TypeArray, "")
+		if err != nil {
+			return datatypeView{}, fmt.Errorf("%s.%s: %s", slType.Name, name, err)
+		}
+
+		if property.Form == "relational" && !property.TypeArray {
+			goType = "*" + goType
+		}
+
+		if property.Type == "dateTime" {
+			datatype.UsesTime = true
+		}
+
+		datatype.Fields = append(datatype.Fields, fieldView{
+			Name: exportedName(property.Name),
+			Type: goType,
+			Tag:  fmt.Sprintf("json:\"%s,omitempty\"", property.Name),
+			Doc:  docComment(property.Doc),
+		})
+	}
+
+	return datatype, nil
+}
+
+func newServiceView(metadata Metadata, slType Type, config Config) (serviceView, error) {
+	service := serviceView{
+		Name:     slType.Name,
+		Doc:      docComment(slType.TypeDoc),
+		Receiver: receiverName(slType.Name),
+
+		DatatypesImportPath: config.ImportPath + "/" + DATATYPES_DIR,
+		ServicesImportPath:  config.ImportPath + "/" + SERVICES_DIR,
+		SoftLayerImportPath: SOFTLAYER_IMPORT_PATH,
+	}
+
+	names := []string{}
+	for name := range slType.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		slMethod := slType.Methods[name]
+
+		method := methodView{
+			Name:    exportedName(slMethod.Name),
+			ApiName: slMethod.Name,
+			Doc:     docComment(slMethod.Doc),
+			Static:  slMethod.Static,
+		}
+
+		if slMethod.Type != "void" && slMethod.Type != "" {
+			resultType, err := goType(metadata, slMethod.Type, slMethod.TypeArray, "datatypes.")
+			if err != nil {
+				return serviceView{}, fmt.Errorf("%s::%s: %s", slType.Name, name, err)
+			}
+			method.ResultType = resultType
+		}
+
+		for _, slParameter := range slMethod.Parameters {
+			parameterType, err := goType(metadata, slParameter.Type, slParameter.TypeArray, "datatypes.")
+			if err != nil {
+				return serviceView{}, fmt.Errorf("%s::%s(%s): %s", slType.Name, name, slParameter.Name, err)
+			}
+
+			parameterName := parameterName(slParameter.Name, service.Receiver)
+			method.Parameters = append(method.Parameters, parameterView{
+				Name:      parameterName,
+				FieldName: exportedName(parameterName),
+				Type:      parameterType,
+			})
+		}
+
+		if strings.Contains(method.ResultType, "datatypes.") {
+			service.UsesDatatypes = true
+		}
+		for _, parameter := range method.Parameters {
+			if strings.Contains(parameter.Type, "datatypes.") {
+				service.UsesDatatypes = true
+			}
+		}
+
+		service.Methods = append(service.Methods, method)
+	}
+
+	return service, nil
+}
+
+func render(files map[string][]byte, path string, tmpl *template.Template, data interface{}) error {
+	source := new(bytes.Buffer)
+	err := tmpl.Execute(source, data)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %s", path, err)
+	}
+
+	files[path] = formatted
+
+	return nil
+}
+
+func goType(metadata Metadata, slType string, array bool, qualifier string) (string, error) {
+	var goType string
+
+	switch slType {
+	case "int", "integer":
+		goType = "int"
+	case "unsignedInt":
+		goType = "uint"
+	case "long":
+		goType = "int64"
+	case "unsignedLong":
+		goType = "uint64"
+	case "boolean":
+		goType = "bool"
+	case "string":
+		goType = "string"
+	case "decimal", "float", "double":
+		goType = "float64"
+	case "dateTime":
+		goType = "*time.Time"
+	case "base64Binary":
+		goType = "[]byte"
+	case "json":
+		goType = "interface{}"
+	default:
+		if _, ok := metadata[slType]; !ok {
+			return "", fmt.Errorf("unknown type %s", slType)
+		}
+		goType = qualifier + slType
+	}
+
+	if array {
+		if goType == "*time.Time" {
+			goType = "time.Time"
+		}
+		goType = "[]" + goType
+	}
+
+	return goType, nil
+}
+
+func sortedTypeNames(metadata Metadata) []string {
+	names := []string{}
+	for name := range metadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// parameterName avoids the Go keywords and the names used by the generated
+// methods themselves.
+func parameterName(name string, receiver string) string {
+	switch {
+	case token.Lookup(name).IsKeyword(),
+		name == "ctx", name == "id", name == "options", name == "result", name == "err", name == receiver:
+		return name + "Param"
+	}
+
+	return name
+}
+
+// receiverName follows the services package, e.g. slnvs for
+// SoftLayer_Network_Vlan.
+func receiverName(typeName string) string {
+	receiver := "sl"
+	for _, segment := range strings.Split(strings.TrimPrefix(typeName, "SoftLayer_"), "_") {
+		if segment != "" {
+			receiver += strings.ToLower(segment[:1])
+		}
+	}
+
+	return receiver + "s"
+}
+
+func docComment(doc string) string {
+	return strings.Join(strings.Fields(doc), " ")
+}
@@ -0,0 +1,230 @@
+package generator_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	generator "github.com/maximilien/softlayer-go/generator"
+	generatedservices "github.com/maximilien/softlayer-go/test_fixtures/generator/generated/services"
+	generatedfakes "github.com/maximilien/softlayer-go/test_fixtures/generator/generated/services/fakes"
+	interfaces "github.com/maximilien/softlayer-go/test_fixtures/generator/interfaces"
+	interfacesfakes "github.com/maximilien/softlayer-go/test_fixtures/generator/interfaces/fakes"
+)
+
+const (
+	GENERATED_IMPORT_PATH  = "github.com/maximilien/softlayer-go/test_fixtures/generator/generated"
+	INTERFACES_IMPORT_PATH = "github.com/maximilien/softlayer-go/test_fixtures/generator/interfaces"
+)
+
+var _ = Describe("Generator", func() {
+	var (
+		fixturesDir string
+		metadata    generator.Metadata
+		err         error
+	)
+
+	BeforeEach(func() {
+		wd, _ := os.Getwd()
+		fixturesDir = filepath.Join(wd, "..", "test_fixtures", "generator")
+
+		metadata, err = generator.LoadMetadata(filepath.Join(fixturesDir, "metadata.json"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("#Generate", func() {
+		var files map[string][]byte
+
+		BeforeEach(func() {
+			files, err = generator.Generate(metadata, generator.Config{ImportPath: GENERATED_IMPORT_PATH})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("matches the checked-in output for the metadata snapshot", func() {
+			checkedIn := []string{}
+			err := filepath.Walk(filepath.Join(fixturesDir, "generated"), func(path string, info os.FileInfo, err error) error {
+				if err == nil && !info.IsDir() {
+					relativePath, _ := filepath.Rel(filepath.Join(fixturesDir, "generated"), path)
+					checkedIn = append(checkedIn, relativePath)
+				}
+				return err
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkedIn).To(HaveLen(len(files)))
+
+			for path, source := range files {
+				expected, err := ioutil.ReadFile(filepath.Join(fixturesDir, "generated", path))
+				Expect(err).ToNot(HaveOccurred(), "%s is not checked in, regenerate the fixtures with main/slgo_gen", path)
+				Expect(string(source)).To(Equal(string(expected)), "%s is out of date, regenerate the fixtures with main/slgo_gen", path)
+			}
+		})
+
+		It("generates a datatype for every type", func() {
+			Expect(files).To(HaveKey("data_types/softlayer_entity.go"))
+			Expect(files).To(HaveKey("data_types/softlayer_network_subnet_ipaddress.go"))
+		})
+
+		It("generates services and fakes only for the types with methods", func() {
+			Expect(files).To(HaveKey("services/softlayer_network_vlan.go"))
+			Expect(files).To(HaveKey("services/fakes/softlayer_network_vlan_fake.go"))
+			Expect(files).ToNot(HaveKey("services/softlayer_network_subnet_ipaddress.go"))
+			Expect(files).ToNot(HaveKey("services/softlayer_entity.go"))
+		})
+
+		It("tags the properties with their API names", func() {
+			vlan := string(files["data_types/softlayer_network_vlan.go"])
+			Expect(vlan).To(ContainSubstring("`json:\"id,omitempty\"`"))
+			Expect(vlan).ToNot(ContainSubstring("`json:\"Id"))
+		})
+
+		It("omits the empty local properties", func() {
+			vlan := string(files["data_types/softlayer_network_vlan.go"])
+			Expect(vlan).ToNot(MatchRegexp("`json:\"[a-zA-Z]+\"`"))
+		})
+
+		It("generates the relational properties as pointers", func() {
+			vlan := string(files["data_types/softlayer_network_vlan.go"])
+			Expect(vlan).To(ContainSubstring("*SoftLayer_Network_Subnet  `json:\"primarySubnet,omitempty\"`"))
+			Expect(vlan).To(ContainSubstring("[]SoftLayer_Network_Subnet `json:\"subnets,omitempty\"`"))
+		})
+
+		It("renames the parameters clashing with Go keywords", func() {
+			subnet := string(files["services/softlayer_network_subnet.go"])
+			Expect(subnet).To(ContainSubstring("Route(ctx context.Context, id int, typeParam string, identifier string, options *softlayer.RequestOptions) (bool, error)"))
+		})
+
+		It("leaves the init parameter out of static methods", func() {
+			subnet := string(files["services/softlayer_network_subnet.go"])
+			Expect(subnet).To(ContainSubstring("GetSubnetForIpAddress(ctx context.Context, ipAddress string, options *softlayer.RequestOptions)"))
+		})
+
+		It("fails on a reference to an unknown type", func() {
+			vlan := metadata["SoftLayer_Network_Vlan"]
+			vlan.Properties["networkSpace"] = generator.Property{Name: "networkSpace", Type: "SoftLayer_Network_Space", Form: "relational"}
+
+			_, err := generator.Generate(metadata, generator.Config{ImportPath: GENERATED_IMPORT_PATH})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("SoftLayer_Network_Vlan.networkSpace: unknown type SoftLayer_Network_Space"))
+		})
+	})
+
+	Context("generated services", func() {
+		It("invoke their methods through the client", func() {
+			fakeClient := slclientfakes.NewFakeSoftLayerClient("fake-username", "fake-api-key")
+			fakeClient.DoRawHttpRequestResponse = []byte("true")
+
+			subnetService := generatedservices.NewSoftLayer_Network_Subnet_Service(fakeClient)
+			routed, err := subnetService.Route(context.Background(), 1234, "SoftLayer_Network_Subnet_IpAddress", "10.0.0.1", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(routed).To(BeTrue())
+
+			Expect(fakeClient.Invocations).To(HaveLen(1))
+			Expect(fakeClient.Invocations[0].Service).To(Equal("SoftLayer_Network_Subnet"))
+			Expect(fakeClient.Invocations[0].Id).To(Equal(1234))
+			Expect(fakeClient.Invocations[0].Method).To(Equal("route"))
+			Expect(fakeClient.Invocations[0].Parameters).To(Equal([]interface{}{"SoftLayer_Network_Subnet_IpAddress", "10.0.0.1"}))
+		})
+	})
+
+	Context("generated fakes", func() {
+		It("record their calls and return the configured result", func() {
+			fakeSubnetService := &generatedfakes.FakeSoftLayer_Network_Subnet_Service{}
+			fakeSubnetService.EditNoteResult = true
+
+			var subnetService generatedservices.SoftLayer_Network_Subnet_Service = fakeSubnetService
+			edited, err := subnetService.EditNote(context.Background(), 1234, "fake-note", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+
+			Expect(fakeSubnetService.EditNoteCalls).To(HaveLen(1))
+			Expect(fakeSubnetService.EditNoteCalls[0].Id).To(Equal(1234))
+			Expect(fakeSubnetService.EditNoteCalls[0].Note).To(Equal("fake-note"))
+		})
+	})
+
+	Context("#GenerateInterfaceFakes", func() {
+		var files map[string][]byte
+
+		BeforeEach(func() {
+			files, err = generator.GenerateInterfaceFakes(filepath.Join(fixturesDir, "interfaces"), INTERFACES_IMPORT_PATH)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("matches the checked-in fakes", func() {
+			checkedIn, err := filepath.Glob(filepath.Join(fixturesDir, "interfaces", "fakes", "*.go"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkedIn).To(HaveLen(len(files)))
+
+			for path, source := range files {
+				expected, err := ioutil.ReadFile(filepath.Join(fixturesDir, "interfaces", "fakes", path))
+				Expect(err).ToNot(HaveOccurred(), "%s is not checked in, regenerate the fixtures with main/slgo_fakes", path)
+				Expect(string(source)).To(Equal(string(expected)), "%s is out of date, regenerate the fixtures with main/slgo_fakes", path)
+			}
+		})
+
+		It("generates a fake for every exported interface", func() {
+			Expect(files).To(HaveKey("named_fake.go"))
+			Expect(files).To(HaveKey("widget_service_fake.go"))
+			Expect(files).To(HaveLen(2))
+		})
+
+		It("includes the methods of the embedded interfaces", func() {
+			Expect(string(files["widget_service_fake.go"])).To(ContainSubstring("func (fake *FakeWidget_Service) GetName() string {"))
+		})
+
+		It("fails on a missing package", func() {
+			_, err := generator.GenerateInterfaceFakes(filepath.Join(fixturesDir, "missing"), INTERFACES_IMPORT_PATH)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("generated interface fakes", func() {
+		var (
+			fakeWidgetService *interfacesfakes.FakeWidget_Service
+			widgetService     interfaces.Widget_Service
+		)
+
+		BeforeEach(func() {
+			fakeWidgetService = &interfacesfakes.FakeWidget_Service{}
+			widgetService = fakeWidgetService
+		})
+
+		It("record their calls and return the configured results", func() {
+			fakeWidgetService.SplitResult1 = "fake-head"
+			fakeWidgetService.SplitResult2 = 2
+
+			head, count, err := widgetService.Split(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(head).To(Equal("fake-head"))
+			Expect(count).To(Equal(2))
+			Expect(fakeWidgetService.SplitCalls).To(HaveLen(1))
+		})
+
+		It("record the variadic arguments as a slice", func() {
+			fakeWidgetService.TagError = errors.New("fake-error")
+
+			err := widgetService.Tag(1234, "fake-tag1", "fake-tag2")
+			Expect(err).To(MatchError("fake-error"))
+			Expect(fakeWidgetService.TagCalls[0].Id).To(Equal(1234))
+			Expect(fakeWidgetService.TagCalls[0].Tags).To(Equal([]string{"fake-tag1", "fake-tag2"}))
+		})
+
+		It("call the stub when set", func() {
+			fakeWidgetService.GetWidgetStub = func(ctx context.Context, id int, options *interfaces.Options) (datatypes.SoftLayer_Virtual_Guest, error) {
+				return datatypes.SoftLayer_Virtual_Guest{Id: id}, nil
+			}
+
+			virtualGuest, err := widgetService.GetWidget(context.Background(), 1234, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuest.Id).To(Equal(1234))
+			Expect(fakeWidgetService.GetWidgetCalls).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,385 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const INTERFACE_FAKES_HEADER = "// Code generated by slgo-fakes. DO NOT EDIT."
+
+type interfaceFakeView struct {
+	Package   string
+	Name      string
+	Qualifier string
+
+	// StdImports are the imports of the standard library, Imports the other
+	// ones, named as in the faked package.
+	StdImports []importView
+	Imports    []importView
+
+	Methods []fakeMethodView
+}
+
+type importView struct {
+	Name string
+	Path string
+}
+
+type fakeMethodView struct {
+	Name       string
+	Parameters []fakeParameterView
+	Results    []fakeResultView
+}
+
+type fakeParameterView struct {
+	Name      string
+	FieldName string
+	Type      string
+
+	// FieldType is Type with a variadic ...T recorded as []T.
+	FieldType string
+	Variadic  bool
+}
+
+type fakeResultView struct {
+	FieldName string
+	Type      string
+}
+
+// interfacePackage is a parsed Go package, with what is needed to render its
+// interfaces from another package.
+type interfacePackage struct {
+	name       string
+	importPath string
+	types      map[string]bool
+	interfaces map[string]*ast.InterfaceType
+
+	// imports are the import paths of the packages used by each interface,
+	// by the name they are used with.
+	imports map[string]map[string]string
+}
+
+// GenerateInterfaceFakes returns a call-recording, stubbable fake for every
+// interface of the Go package in sourceDir, whose import path is importPath.
+// The fakes are in the <package>_fakes package, formatted and indexed by file
+// name.
+func GenerateInterfaceFakes(sourceDir string, importPath string) (map[string][]byte, error) {
+	pkg, err := parseInterfacePackage(sourceDir, importPath)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for name := range pkg.interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	files := map[string][]byte{}
+	for _, name := range names {
+		fake, err := pkg.newInterfaceFakeView(name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = render(files, strings.ToLower(name)+"_fake.go", interfaceFakeTemplate, fake)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+//Private methods
+
+func (pkg *interfacePackage) newInterfaceFakeView(name string) (interfaceFakeView, error) {
+	fake := interfaceFakeView{
+		Package:   pkg.name + "_fakes",
+		Name:      name,
+		Qualifier: pkg.name,
+	}
+
+	usedImports := map[string]string{pkg.name: pkg.importPath, "sync": "sync"}
+
+	methods, err := pkg.methods(name, map[string]bool{})
+	if err != nil {
+		return interfaceFakeView{}, err
+	}
+
+	for _, interfaceMethod := range methods {
+		funcType := interfaceMethod.field.Type.(*ast.FuncType)
+		method := fakeMethodView{Name: interfaceMethod.field.Names[0].Name}
+
+		for i, param := range fieldList(funcType.Params) {
+			paramName := param.name
+			switch paramName {
+			case "", "_":
+				paramName = fmt.Sprintf("arg%d", i+1)
+			case "fake", "stub":
+				paramName += "Param"
+			}
+
+			paramType, err := pkg.typeString(interfaceMethod.owner, param.expr, usedImports)
+			if err != nil {
+				return interfaceFakeView{}, err
+			}
+
+			parameter := fakeParameterView{
+				Name:      paramName,
+				FieldName: exportedName(paramName),
+				Type:      paramType,
+				FieldType: paramType,
+			}
+
+			if strings.HasPrefix(paramType, "...") {
+				parameter.Variadic = true
+				parameter.FieldType = "[]" + strings.TrimPrefix(paramType, "...")
+			}
+
+			method.Parameters = append(method.Parameters, parameter)
+		}
+
+		results := fieldList(funcType.Results)
+		nonErrorResults := 0
+		for _, result := range results {
+			if ident, ok := result.expr.(*ast.Ident); !ok || ident.Name != "error" {
+				nonErrorResults++
+			}
+		}
+
+		for _, result := range results {
+			resultType, err := pkg.typeString(interfaceMethod.owner, result.expr, usedImports)
+			if err != nil {
+				return interfaceFakeView{}, err
+			}
+
+			fieldName := "Result"
+			switch {
+			case resultType == "error":
+				fieldName = "Error"
+			case nonErrorResults > 1:
+				fieldName = fmt.Sprintf("Result%d", len(method.Results)+1)
+			}
+
+			method.Results = append(method.Results, fakeResultView{FieldName: fieldName, Type: resultType})
+		}
+
+		fake.Methods = append(fake.Methods, method)
+	}
+
+	importNames := []string{}
+	for importName := range usedImports {
+		importNames = append(importNames, importName)
+	}
+	sort.Strings(importNames)
+
+	for _, importName := range importNames {
+		importPath := usedImports[importName]
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			fake.Imports = append(fake.Imports, importView{Name: importName, Path: importPath})
+		} else {
+			fake.StdImports = append(fake.StdImports, importView{Name: importName, Path: importPath})
+		}
+	}
+
+	return fake, nil
+}
+
+// interfaceMethod is a method of an interface, declared by the owner
+// interface, which is either the interface itself or one it embeds.
+type interfaceMethod struct {
+	owner string
+	field *ast.Field
+}
+
+// methods returns the methods of an interface, including the ones of the
+// interfaces it embeds, sorted by name.
+func (pkg *interfacePackage) methods(name string, visited map[string]bool) ([]interfaceMethod, error) {
+	if visited[name] {
+		return []interfaceMethod{}, nil
+	}
+	visited[name] = true
+
+	interfaceType, ok := pkg.interfaces[name]
+	if !ok {
+		return nil, fmt.Errorf("%s is not an interface of package %s", name, pkg.name)
+	}
+
+	methodsByName := map[string]interfaceMethod{}
+	for _, field := range interfaceType.Methods.List {
+		if len(field.Names) > 0 {
+			methodsByName[field.Names[0].Name] = interfaceMethod{owner: name, field: field}
+			continue
+		}
+
+		embedded, ok := field.Type.(*ast.Ident)
+		if !ok {
+			return nil, fmt.Errorf("%s embeds an interface of another package, which is not supported", name)
+		}
+
+		embeddedMethods, err := pkg.methods(embedded.Name, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, embeddedMethod := range embeddedMethods {
+			methodsByName[embeddedMethod.field.Names[0].Name] = embeddedMethod
+		}
+	}
+
+	methodNames := []string{}
+	for methodName := range methodsByName {
+		methodNames = append(methodNames, methodName)
+	}
+	sort.Strings(methodNames)
+
+	methods := []interfaceMethod{}
+	for _, methodName := range methodNames {
+		methods = append(methods, methodsByName[methodName])
+	}
+
+	return methods, nil
+}
+
+// typeString renders a type of the interface for the fakes package, with
+// the types of the faked package qualified by its name.
+func (pkg *interfacePackage) typeString(interfaceName string, expr ast.Expr, usedImports map[string]string) (string, error) {
+	switch typed := expr.(type) {
+	case *ast.Ident:
+		if pkg.types[typed.Name] {
+			return pkg.name + "." + typed.Name, nil
+		}
+		return typed.Name, nil
+	case *ast.SelectorExpr:
+		packageIdent, ok := typed.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("unsupported type in %s", interfaceName)
+		}
+
+		importPath, ok := pkg.imports[interfaceName][packageIdent.Name]
+		if !ok {
+			return "", fmt.Errorf("unknown package %s in %s", packageIdent.Name, interfaceName)
+		}
+		usedImports[packageIdent.Name] = importPath
+
+		return packageIdent.Name + "." + typed.Sel.Name, nil
+	case *ast.StarExpr:
+		elem, err := pkg.typeString(interfaceName, typed.X, usedImports)
+		return "*" + elem, err
+	case *ast.Ellipsis:
+		elem, err := pkg.typeString(interfaceName, typed.Elt, usedImports)
+		return "..." + elem, err
+	case *ast.ArrayType:
+		elem, err := pkg.typeString(interfaceName, typed.Elt, usedImports)
+		return "[]" + elem, err
+	case *ast.MapType:
+		key, err := pkg.typeString(interfaceName, typed.Key, usedImports)
+		if err != nil {
+			return "", err
+		}
+		value, err := pkg.typeString(interfaceName, typed.Value, usedImports)
+		return "map[" + key + "]" + value, err
+	case *ast.InterfaceType:
+		if len(typed.Methods.List) == 0 {
+			return "interface{}", nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported type in %s", interfaceName)
+}
+
+//Private functions
+
+func parseInterfacePackage(sourceDir string, importPath string) (*interfacePackage, error) {
+	paths, err := filepath.Glob(filepath.Join(sourceDir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &interfacePackage{
+		importPath: importPath,
+		types:      map[string]bool{},
+		interfaces: map[string]*ast.InterfaceType{},
+		imports:    map[string]map[string]string{},
+	}
+
+	fileSet := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		pkg.name = file.Name.Name
+
+		fileImports := map[string]string{}
+		for _, fileImport := range file.Imports {
+			path, _ := strconv.Unquote(fileImport.Path.Value)
+			name := filepath.Base(path)
+			if fileImport.Name != nil {
+				name = fileImport.Name.Name
+			}
+			fileImports[name] = path
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				pkg.types[typeSpec.Name.Name] = true
+
+				if interfaceType, ok := typeSpec.Type.(*ast.InterfaceType); ok && typeSpec.Name.IsExported() {
+					pkg.interfaces[typeSpec.Name.Name] = interfaceType
+					pkg.imports[typeSpec.Name.Name] = fileImports
+				}
+			}
+		}
+	}
+
+	if pkg.name == "" {
+		return nil, fmt.Errorf("no Go package in %s", sourceDir)
+	}
+
+	return pkg, nil
+}
+
+type namedExpr struct {
+	name string
+	expr ast.Expr
+}
+
+// fieldList flattens the parameters or results of a function, e.g.
+// (a, b int) into a int and b int.
+func fieldList(fields *ast.FieldList) []namedExpr {
+	namedExprs := []namedExpr{}
+	if fields == nil {
+		return namedExprs
+	}
+
+	for _, field := range fields.List {
+		if len(field.Names) == 0 {
+			namedExprs = append(namedExprs, namedExpr{expr: field.Type})
+			continue
+		}
+
+		for _, name := range field.Names {
+			namedExprs = append(namedExprs, namedExpr{name: name.Name, expr: field.Type})
+		}
+	}
+
+	return namedExprs
+}
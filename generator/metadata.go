@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+// Metadata is the SoftLayer API metadata, as published at
+// https://api.softlayer.com/metadata/v3.1, indexed by type name.
+type Metadata map[string]Type
+
+type Type struct {
+	Name      string `json:"name"`
+	Base      string `json:"base"`
+	TypeDoc   string `json:"typeDoc"`
+	NoService bool   `json:"noservice"`
+
+	Properties map[string]Property `json:"properties"`
+	Methods    map[string]Method   `json:"methods"`
+}
+
+type Property struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	TypeArray bool   `json:"typeArray"`
+	Doc       string `json:"doc"`
+
+	// Form is local for the properties stored on the object, relational for
+	// the related objects and count for the number of related objects.
+	Form string `json:"form"`
+}
+
+type Method struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	TypeArray bool   `json:"typeArray"`
+	Doc       string `json:"doc"`
+
+	// Static methods are not called on an object and take no init parameter.
+	Static bool `json:"static"`
+
+	Parameters []Parameter `json:"parameters"`
+}
+
+type Parameter struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	TypeArray bool   `json:"typeArray"`
+	Doc       string `json:"doc"`
+}
+
+func LoadMetadata(path string) (Metadata, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := Metadata{}
+	err = json.Unmarshal(data, &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,243 @@
+package generator
+
+import (
+	"strings"
+	"text/template"
+)
+
+const GENERATED_HEADER = "// Code generated by slgo-gen from the SoftLayer API metadata. DO NOT EDIT."
+
+var templateFuncs = template.FuncMap{
+	"comment":              comment,
+	"header":               func() string { return GENERATED_HEADER },
+	"interfaceFakesHeader": func() string { return INTERFACE_FAKES_HEADER },
+	"lowerFirst":           lowerFirst,
+}
+
+var datatypeTemplate = template.Must(template.New("datatype").Funcs(templateFuncs).Parse(`{{header}}
+
+package data_types
+{{if .UsesTime}}
+import (
+	"time"
+)
+{{end}}
+{{comment .Doc ""}}type {{.Name}} struct {
+{{- range .Fields}}
+	{{comment .Doc "\t"}}{{.Name}} {{.Type}} ` + "`{{.Tag}}`" + `
+{{- end}}
+}
+`))
+
+var serviceTemplate = template.Must(template.New("service").Funcs(templateFuncs).Parse(`{{header}}
+
+package services
+
+import (
+	"context"
+{{if .UsesDatatypes}}
+	datatypes "{{.DatatypesImportPath}}"{{end}}
+	softlayer "{{.SoftLayerImportPath}}"
+)
+
+{{comment .Doc ""}}type {{.Name}}_Service interface {
+	softlayer.Service
+{{range .Methods}}
+	{{comment .Doc "\t"}}{{template "signature" .}}
+{{- end}}
+}
+
+type {{.Name | printf "%s_Service" | lowerFirst}} struct {
+	client softlayer.Client
+}
+
+func New{{.Name}}_Service(client softlayer.Client) *{{.Name | printf "%s_Service" | lowerFirst}} {
+	return &{{.Name | printf "%s_Service" | lowerFirst}}{
+		client: client,
+	}
+}
+
+func ({{.Receiver}} *{{.Name | printf "%s_Service" | lowerFirst}}) GetName() string {
+	return "{{.Name}}"
+}
+{{$service := .}}
+{{- range .Methods}}
+func ({{$service.Receiver}} *{{$service.Name | printf "%s_Service" | lowerFirst}}) {{template "signature" .}} {
+	invocation := softlayer.Invocation{
+		Service: {{$service.Receiver}}.GetName(),
+{{- if not .Static}}
+		Id:      id,
+{{- end}}
+		Method:  "{{.ApiName}}",
+{{- if .Parameters}}
+		Parameters: []interface{}{
+{{- range .Parameters}}
+			{{.Name}},
+{{- end}}
+		},
+{{- end}}
+		Options: options,
+	}
+{{if .ResultType}}
+	var result {{.ResultType}}
+	err := {{$service.Receiver}}.client.Invoke(ctx, invocation, &result)
+
+	return result, err
+{{- else}}
+	return {{$service.Receiver}}.client.Invoke(ctx, invocation, nil)
+{{- end}}
+}
+{{end}}
+{{- define "signature"}}{{.Name}}(ctx context.Context,{{if not .Static}} id int,{{end}}{{range .Parameters}} {{.Name}} {{.Type}},{{end}} options *softlayer.RequestOptions) {{if .ResultType}}({{.ResultType}}, error){{else}}error{{end}}{{end}}
+`))
+
+var serviceFakeTemplate = template.Must(template.New("serviceFake").Funcs(templateFuncs).Parse(`{{header}}
+
+package services_fakes
+
+import (
+	"context"
+	"sync"
+{{if .UsesDatatypes}}
+	datatypes "{{.DatatypesImportPath}}"{{end}}
+	services "{{.ServicesImportPath}}"
+	softlayer "{{.SoftLayerImportPath}}"
+)
+
+var _ services.{{.Name}}_Service = new(Fake{{.Name}}_Service)
+
+type Fake{{.Name}}_Service struct {
+	mutex sync.Mutex
+{{range .Methods}}
+	{{.Name}}Stub func{{template "parameters" .}}
+	{{.Name}}Calls []struct {
+		Ctx context.Context
+{{- if not .Static}}
+		Id int
+{{- end}}
+{{- range .Parameters}}
+		{{.FieldName}} {{.Type}}
+{{- end}}
+		Options *softlayer.RequestOptions
+	}
+{{- if .ResultType}}
+	{{.Name}}Result {{.ResultType}}
+{{- end}}
+	{{.Name}}Error error
+{{end}}
+}
+
+func (fake *Fake{{.Name}}_Service) GetName() string {
+	return "{{.Name}}"
+}
+{{range .Methods}}
+func (fake *Fake{{$.Name}}_Service) {{.Name}}{{template "parameters" .}} {
+	fake.mutex.Lock()
+	fake.{{.Name}}Calls = append(fake.{{.Name}}Calls, struct {
+		Ctx context.Context
+{{- if not .Static}}
+		Id int
+{{- end}}
+{{- range .Parameters}}
+		{{.FieldName}} {{.Type}}
+{{- end}}
+		Options *softlayer.RequestOptions
+	}{ctx,{{if not .Static}} id,{{end}}{{range .Parameters}} {{.Name}},{{end}} options})
+	stub := fake.{{.Name}}Stub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx,{{if not .Static}} id,{{end}}{{range .Parameters}} {{.Name}},{{end}} options)
+	}
+{{if .ResultType}}
+	return fake.{{.Name}}Result, fake.{{.Name}}Error
+{{- else}}
+	return fake.{{.Name}}Error
+{{- end}}
+}
+{{end}}
+{{- define "parameters"}}(ctx context.Context,{{if not .Static}} id int,{{end}}{{range .Parameters}} {{.Name}} {{.Type}},{{end}} options *softlayer.RequestOptions) {{if .ResultType}}({{.ResultType}}, error){{else}}error{{end}}{{end}}
+`))
+
+var interfaceFakeTemplate = template.Must(template.New("interfaceFake").Funcs(templateFuncs).Parse(`{{interfaceFakesHeader}}
+
+package {{.Package}}
+
+import (
+{{- range .StdImports}}
+	"{{.Path}}"
+{{- end}}
+{{range .Imports}}
+	{{.Name}} "{{.Path}}"
+{{- end}}
+)
+
+var _ {{.Qualifier}}.{{.Name}} = new(Fake{{.Name}})
+
+type Fake{{.Name}} struct {
+	mutex sync.Mutex
+{{range .Methods}}
+	{{.Name}}Stub func{{template "signature" .}}
+	{{.Name}}Calls []{{template "call" .}}
+{{- $method := .}}
+{{- range .Results}}
+	{{$method.Name}}{{.FieldName}} {{.Type}}
+{{- end}}
+{{end}}
+}
+{{range .Methods}}
+func (fake *Fake{{$.Name}}) {{.Name}}{{template "signature" .}} {
+	fake.mutex.Lock()
+	fake.{{.Name}}Calls = append(fake.{{.Name}}Calls, {{template "call" .}}{ {{- range $i, $parameter := .Parameters}}{{if $i}}, {{end}}{{.Name}}{{end -}} })
+	stub := fake.{{.Name}}Stub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		{{if .Results}}return {{end}}stub({{template "arguments" .}})
+{{- if not .Results}}
+		return
+{{- end}}
+	}
+{{- if .Results}}
+
+	return {{$method := .}}{{range $i, $result := .Results}}{{if $i}}, {{end}}fake.{{$method.Name}}{{.FieldName}}{{end}}
+{{- end}}
+}
+{{end}}
+{{- define "signature"}}({{range $i, $parameter := .Parameters}}{{if $i}}, {{end}}{{.Name}} {{.Type}}{{end}}){{if eq (len .Results) 1}} {{(index .Results 0).Type}}{{else if .Results}} ({{range $i, $result := .Results}}{{if $i}}, {{end}}{{.Type}}{{end}}){{end}}{{end}}
+{{- define "call"}}struct{{if .Parameters}} {
+{{- range .Parameters}}
+		{{.FieldName}} {{.FieldType}}
+{{- end}}
+	}{{else}}{}{{end}}{{end}}
+{{- define "arguments"}}{{range $i, $parameter := .Parameters}}{{if $i}}, {{end}}{{.Name}}{{if .Variadic}}...{{end}}{{end}}{{end}}
+`))
+
+// comment renders doc as a comment wrapped at 80 columns, followed by a new
+// line and indent, or nothing when doc is empty.
+func comment(doc string, indent string) string {
+	if doc == "" {
+		return ""
+	}
+
+	lines := []string{}
+	line := "//"
+	for _, word := range strings.Fields(doc) {
+		if len(line) > 2 && len(indent)*4+len(line)+1+len(word) > 80 {
+			lines = append(lines, line)
+			line = "//"
+		}
+		line += " " + word
+	}
+	lines = append(lines, line)
+
+	return strings.Join(lines, "\n"+indent) + "\n" + indent
+}
+
+func lowerFirst(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToLower(name[:1]) + name[1:]
+}
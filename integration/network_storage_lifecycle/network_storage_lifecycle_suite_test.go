@@ -5,9 +5,25 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
 )
 
 func TestServices(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Integration: Network Storage Lifecycle Suite")
 }
+
+var _ = BeforeSuite(func() {
+	testhelpers.StartSimulator()
+
+	err := testhelpers.StartRecorder("network_storage_lifecycle")
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	err := testhelpers.StopRecorder()
+	Expect(err).ToNot(HaveOccurred())
+
+	testhelpers.StopSimulator()
+})
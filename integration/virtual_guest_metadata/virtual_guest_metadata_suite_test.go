@@ -14,6 +14,20 @@ func TestServices(t *testing.T) {
 	RunSpecs(t, "Integration: Network Storage Lifecycle Suite")
 }
 
+var _ = BeforeSuite(func() {
+	testhelpers.StartSimulator()
+
+	err := testhelpers.StartRecorder("virtual_guest_metadata")
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	err := testhelpers.StopRecorder()
+	Expect(err).ToNot(HaveOccurred())
+
+	testhelpers.StopSimulator()
+})
+
 func cleanUpTestResources() {
 	virtualGuestIds, err := testhelpers.FindAndDeleteTestVirtualGuests()
 	Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	generator "github.com/maximilien/softlayer-go/generator"
+)
+
+func main() {
+	sourceDir := flag.String("source", "softlayer", "directory of the Go package whose interfaces are faked")
+	importPath := flag.String("import-path", "github.com/maximilien/softlayer-go/softlayer", "import path of the faked package")
+	outputDir := flag.String("output", "softlayer/fakes", "directory the fakes package is written to")
+	flag.Parse()
+
+	files, err := generator.GenerateInterfaceFakes(*sourceDir, *importPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "slgo-fakes: %s\n", err)
+		os.Exit(1)
+	}
+
+	err = generator.WriteFiles(*outputDir, files)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "slgo-fakes: %s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("slgo-fakes: generated %d files in %s\n", len(files), *outputDir)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	generator "github.com/maximilien/softlayer-go/generator"
+)
+
+func main() {
+	metadataPath := flag.String("metadata", "", "path to the SoftLayer API metadata JSON, e.g. saved from https://api.softlayer.com/metadata/v3.1")
+	outputDir := flag.String("output", "generated", "directory the data_types, services and services/fakes packages are written to")
+	importPath := flag.String("import-path", "github.com/maximilien/softlayer-go/generated", "import path of the output directory")
+	flag.Parse()
+
+	if *metadataPath == "" {
+		fmt.Fprintln(os.Stderr, "slgo-gen: -metadata is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	metadata, err := generator.LoadMetadata(*metadataPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "slgo-gen: loading metadata: %s\n", err)
+		os.Exit(1)
+	}
+
+	files, err := generator.Generate(metadata, generator.Config{ImportPath: *importPath})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "slgo-gen: %s\n", err)
+		os.Exit(1)
+	}
+
+	err = generator.WriteFiles(*outputDir, files)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "slgo-gen: %s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("slgo-gen: generated %d files in %s\n", len(files), *outputDir)
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"fmt"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+func newSoftLayerError(service softlayer.Service, method string, path string, response []byte, message string) error {
+	return &softlayer.SoftLayerError{
+		Message: message,
+		Service: service.GetName(),
+		Method:  method,
+		Path:    path,
+		Body:    response,
+	}
+}
+
+func newInvalidJsonResponseError(service softlayer.Service, method string, path string, response []byte, err error) error {
+	return newSoftLayerError(service, method, path, response, fmt.Sprintf("failed to decode JSON response, err message '%s'", err.Error()))
+}
+
+// newNotFoundError is returned when the result of a call does not have the
+// object looked for, so that softlayer.IsNotFound reports it.
+func newNotFoundError(service softlayer.Service, method string, path string, message string) error {
+	slErr := newSoftLayerError(service, method, path, nil, message).(*softlayer.SoftLayerError)
+	slErr.Code = softlayer.SOFTLAYER_EXCEPTION_NOT_FOUND
+
+	return slErr
+}
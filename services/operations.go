@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// newTransactionOperation follows a transaction through the active
+// transactions of its virtual guest.
+func newTransactionOperation(ctx context.Context, client softlayer.Client, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options softlayer.OperationOptions) *softlayer.Operation {
+	virtualGuestService := NewSoftLayer_Virtual_Guest_Service(client)
+
+	return softlayer.NewOperation(ctx, transaction, options, func(ctx context.Context, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction) (datatypes.SoftLayer_Provisioning_Version1_Transaction, bool, error) {
+		if transaction.GuestId == 0 {
+			path := fmt.Sprintf("%s/%d/getActiveTransactions.json", virtualGuestService.GetName(), transaction.GuestId)
+			return transaction, false, newNotFoundError(virtualGuestService, "getActiveTransactions", path, fmt.Sprintf("transaction %d is not a transaction of a virtual guest", transaction.Id))
+		}
+
+		activeTransactions, err := virtualGuestService.GetActiveTransactionsWithContext(ctx, transaction.GuestId)
+		if err != nil {
+			return transaction, false, err
+		}
+
+		return findActiveTransaction(transaction, activeTransactions, nil)
+	})
+}
+
+// newCreatedTransactionOperation waits for a transaction of the virtual guest
+// that is not one of the previous transactions, then follows it.
+func newCreatedTransactionOperation(ctx context.Context, client softlayer.Client, guestId int, previousTransactionIds map[int]bool, options softlayer.OperationOptions) *softlayer.Operation {
+	virtualGuestService := NewSoftLayer_Virtual_Guest_Service(client)
+
+	awaited := datatypes.SoftLayer_Provisioning_Version1_Transaction{GuestId: guestId}
+	return softlayer.NewOperation(ctx, awaited, options, func(ctx context.Context, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction) (datatypes.SoftLayer_Provisioning_Version1_Transaction, bool, error) {
+		activeTransactions, err := virtualGuestService.GetActiveTransactionsWithContext(ctx, guestId)
+		if err != nil {
+			return transaction, false, err
+		}
+
+		return findActiveTransaction(transaction, activeTransactions, previousTransactionIds)
+	})
+}
+
+// findActiveTransaction returns the transaction among the active ones, or the
+// first one not in previousTransactionIds while the transaction has no id.
+func findActiveTransaction(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, activeTransactions []datatypes.SoftLayer_Provisioning_Version1_Transaction, previousTransactionIds map[int]bool) (datatypes.SoftLayer_Provisioning_Version1_Transaction, bool, error) {
+	for _, activeTransaction := range activeTransactions {
+		matches := activeTransaction.Id == transaction.Id
+		if transaction.Id == 0 {
+			matches = !previousTransactionIds[activeTransaction.Id]
+		}
+
+		if matches {
+			if activeTransaction.GuestId == 0 {
+				activeTransaction.GuestId = transaction.GuestId
+			}
+
+			return activeTransaction, true, nil
+		}
+	}
+
+	return transaction, transaction.Id == 0, nil
+}
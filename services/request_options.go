@@ -0,0 +1,30 @@
+package services
+
+import (
+	"bytes"
+	"context"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// doWithDefaultObjectMask makes a GET request with defaultMask when the caller
+// did not set a mask in options. The request is made with a copy of options,
+// so the total items of the response are copied back to options.
+func doWithDefaultObjectMask(ctx context.Context, client softlayer.Client, path string, options *softlayer.RequestOptions, defaultMask []string, result interface{}) error {
+	withDefaults := softlayer.RequestOptions{}
+	if options != nil {
+		withDefaults = *options
+	}
+
+	if withDefaults.Mask.IsEmpty() {
+		withDefaults.Mask = softlayer.NewObjectMask(defaultMask...)
+	}
+
+	err := client.DoHttpRequestWithContext(ctx, path, &withDefaults, "GET", new(bytes.Buffer), result)
+
+	if options != nil {
+		options.TotalItems = withDefaults.TotalItems
+	}
+
+	return err
+}
@@ -2,8 +2,7 @@ package services
 
 import (
 	"bytes"
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
 
 	datatypes "github.com/maximilien/softlayer-go/data_types"
@@ -25,18 +24,14 @@ func (slas *softLayer_Account_Service) GetName() string {
 }
 
 func (slas *softLayer_Account_Service) GetAccountStatus() (datatypes.SoftLayer_Account_Status, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getAccountStatus.json")
-	responseBytes, err := slas.client.DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getAccountStatus, error message '%s'", err.Error())
-		return datatypes.SoftLayer_Account_Status{}, errors.New(errorMessage)
-	}
+	return slas.GetAccountStatusWithContext(context.Background())
+}
 
+func (slas *softLayer_Account_Service) GetAccountStatusWithContext(ctx context.Context) (datatypes.SoftLayer_Account_Status, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getAccountStatus.json")
 	accountStatus := datatypes.SoftLayer_Account_Status{}
-	err = json.Unmarshal(responseBytes, &accountStatus)
+	err := slas.client.DoHttpRequestWithContext(ctx, path, nil, "GET", &bytes.Buffer{}, &accountStatus)
 	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
 		return datatypes.SoftLayer_Account_Status{}, err
 	}
 
@@ -44,47 +39,69 @@ func (slas *softLayer_Account_Service) GetAccountStatus() (datatypes.SoftLayer_A
 }
 
 func (slas *softLayer_Account_Service) GetVirtualGuests() ([]datatypes.SoftLayer_Virtual_Guest, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getVirtualGuests.json")
-	responseBytes, err := slas.client.DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getVirtualGuests, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Virtual_Guest{}, errors.New(errorMessage)
-	}
+	return slas.GetVirtualGuestsWithContext(context.Background())
+}
 
+func (slas *softLayer_Account_Service) GetVirtualGuestsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Guest, error) {
+	return slas.GetVirtualGuestsWithOptions(ctx, nil)
+}
+
+func (slas *softLayer_Account_Service) GetVirtualGuestsWithOptions(ctx context.Context, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Virtual_Guest, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getVirtualGuests.json")
 	virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
-	err = json.Unmarshal(responseBytes, &virtualGuests)
+	err := slas.client.DoHttpRequestWithContext(ctx, path, options, "GET", &bytes.Buffer{}, &virtualGuests)
 	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
 		return []datatypes.SoftLayer_Virtual_Guest{}, err
 	}
 
 	return virtualGuests, nil
 }
 
+func (slas *softLayer_Account_Service) GetVirtualGuestsIterator(ctx context.Context, options *softlayer.RequestOptions, pagerOptions softlayer.PagerOptions) *softlayer.VirtualGuestIterator {
+	return softlayer.NewVirtualGuestIterator(ctx, options, pagerOptions, func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+		page, err := slas.GetVirtualGuestsWithOptions(ctx, options)
+		return page, len(page), err
+	})
+}
+
 func (slas *softLayer_Account_Service) GetNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getNetworkStorage.json")
-	responseBytes, err := slas.client.DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getNetworkStorage, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
-	}
+	return slas.GetNetworkStorageWithContext(context.Background())
+}
 
+func (slas *softLayer_Account_Service) GetNetworkStorageWithContext(ctx context.Context) ([]datatypes.SoftLayer_Network_Storage, error) {
+	return slas.GetNetworkStorageWithOptions(ctx, nil)
+}
+
+func (slas *softLayer_Account_Service) GetNetworkStorageWithOptions(ctx context.Context, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Network_Storage, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getNetworkStorage.json")
 	networkStorage := []datatypes.SoftLayer_Network_Storage{}
-	err = json.Unmarshal(responseBytes, &networkStorage)
+	err := slas.client.DoHttpRequestWithContext(ctx, path, options, "GET", &bytes.Buffer{}, &networkStorage)
 	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
 		return []datatypes.SoftLayer_Network_Storage{}, err
 	}
 
 	return networkStorage, nil
 }
 
+func (slas *softLayer_Account_Service) GetNetworkStorageIterator(ctx context.Context, options *softlayer.RequestOptions, pagerOptions softlayer.PagerOptions) *softlayer.NetworkStorageIterator {
+	return softlayer.NewNetworkStorageIterator(ctx, options, pagerOptions, func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+		page, err := slas.GetNetworkStorageWithOptions(ctx, options)
+		return page, len(page), err
+	})
+}
+
 func (slas *softLayer_Account_Service) GetIscsiNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error) {
+	return slas.GetIscsiNetworkStorageWithContext(context.Background())
+}
+
+func (slas *softLayer_Account_Service) GetIscsiNetworkStorageWithContext(ctx context.Context) ([]datatypes.SoftLayer_Network_Storage, error) {
+	return slas.GetIscsiNetworkStorageWithOptions(ctx, nil)
+}
+
+func (slas *softLayer_Account_Service) GetIscsiNetworkStorageWithOptions(ctx context.Context, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Network_Storage, error) {
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getIscsiNetworkStorage.json")
 
-	objectMasks := []string{
+	objectMask := []string{
 		"username",
 		"accountId",
 		"capacityGb",
@@ -93,100 +110,130 @@ func (slas *softLayer_Account_Service) GetIscsiNetworkStorage() ([]datatypes.Sof
 		"billingItem.orderItem.order.id",
 	}
 
-	responseBytes, err := slas.client.DoRawHttpRequestWithObjectMask(path, objectMasks, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getIscsiNetworkStorage, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
-	}
-
 	networkStorage := []datatypes.SoftLayer_Network_Storage{}
-	err = json.Unmarshal(responseBytes, &networkStorage)
+	err := doWithDefaultObjectMask(ctx, slas.client, path, options, objectMask, &networkStorage)
 	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
 		return []datatypes.SoftLayer_Network_Storage{}, err
 	}
 
 	return networkStorage, nil
 }
 
+func (slas *softLayer_Account_Service) GetIscsiNetworkStorageIterator(ctx context.Context, options *softlayer.RequestOptions, pagerOptions softlayer.PagerOptions) *softlayer.NetworkStorageIterator {
+	return softlayer.NewNetworkStorageIterator(ctx, options, pagerOptions, func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+		page, err := slas.GetIscsiNetworkStorageWithOptions(ctx, options)
+		return page, len(page), err
+	})
+}
+
 func (slas *softLayer_Account_Service) GetVirtualDiskImages() ([]datatypes.SoftLayer_Virtual_Disk_Image, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getVirtualDiskImages.json")
-	responseBytes, err := slas.client.DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could get SoftLayer_Account#getVirtualDiskImages, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Virtual_Disk_Image{}, errors.New(errorMessage)
-	}
+	return slas.GetVirtualDiskImagesWithContext(context.Background())
+}
 
+func (slas *softLayer_Account_Service) GetVirtualDiskImagesWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Disk_Image, error) {
+	return slas.GetVirtualDiskImagesWithOptions(ctx, nil)
+}
+
+func (slas *softLayer_Account_Service) GetVirtualDiskImagesWithOptions(ctx context.Context, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Virtual_Disk_Image, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getVirtualDiskImages.json")
 	virtualDiskImages := []datatypes.SoftLayer_Virtual_Disk_Image{}
-	err = json.Unmarshal(responseBytes, &virtualDiskImages)
+	err := slas.client.DoHttpRequestWithContext(ctx, path, options, "GET", &bytes.Buffer{}, &virtualDiskImages)
 	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
 		return []datatypes.SoftLayer_Virtual_Disk_Image{}, err
 	}
 
 	return virtualDiskImages, nil
 }
 
+func (slas *softLayer_Account_Service) GetVirtualDiskImagesIterator(ctx context.Context, options *softlayer.RequestOptions, pagerOptions softlayer.PagerOptions) *softlayer.VirtualDiskImageIterator {
+	return softlayer.NewVirtualDiskImageIterator(ctx, options, pagerOptions, func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+		page, err := slas.GetVirtualDiskImagesWithOptions(ctx, options)
+		return page, len(page), err
+	})
+}
+
 func (slas *softLayer_Account_Service) GetSshKeys() ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getSshKeys.json")
-	responseBytes, err := slas.client.DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getSshKeys, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Security_Ssh_Key{}, errors.New(errorMessage)
-	}
+	return slas.GetSshKeysWithContext(context.Background())
+}
 
+func (slas *softLayer_Account_Service) GetSshKeysWithContext(ctx context.Context) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
+	return slas.GetSshKeysWithOptions(ctx, nil)
+}
+
+func (slas *softLayer_Account_Service) GetSshKeysWithOptions(ctx context.Context, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getSshKeys.json")
 	sshKeys := []datatypes.SoftLayer_Security_Ssh_Key{}
-	err = json.Unmarshal(responseBytes, &sshKeys)
+	err := slas.client.DoHttpRequestWithContext(ctx, path, options, "GET", &bytes.Buffer{}, &sshKeys)
 	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
 		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
 
 	return sshKeys, nil
 }
 
+func (slas *softLayer_Account_Service) GetSshKeysIterator(ctx context.Context, options *softlayer.RequestOptions, pagerOptions softlayer.PagerOptions) *softlayer.SshKeyIterator {
+	return softlayer.NewSshKeyIterator(ctx, options, pagerOptions, func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+		page, err := slas.GetSshKeysWithOptions(ctx, options)
+		return page, len(page), err
+	})
+}
+
 func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroups() ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getBlockDeviceTemplateGroups.json")
-	responseBytes, err := slas.client.DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getBlockDeviceTemplateGroups, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New(errorMessage)
-	}
+	return slas.GetBlockDeviceTemplateGroupsWithContext(context.Background())
+}
 
+func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroupsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	return slas.GetBlockDeviceTemplateGroupsWithOptions(ctx, nil)
+}
+
+func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroupsWithOptions(ctx context.Context, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getBlockDeviceTemplateGroups.json")
 	vgbdtGroups := []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
-	err = json.Unmarshal(responseBytes, &vgbdtGroups)
+	err := slas.client.DoHttpRequestWithContext(ctx, path, options, "GET", &bytes.Buffer{}, &vgbdtGroups)
 	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
 		return []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
 	}
 
 	return vgbdtGroups, nil
 }
 
+func (slas *softLayer_Account_Service) GetBlockDeviceTemplateGroupsIterator(ctx context.Context, options *softlayer.RequestOptions, pagerOptions softlayer.PagerOptions) *softlayer.BlockDeviceTemplateGroupIterator {
+	return softlayer.NewBlockDeviceTemplateGroupIterator(ctx, options, pagerOptions, func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+		page, err := slas.GetBlockDeviceTemplateGroupsWithOptions(ctx, options)
+		return page, len(page), err
+	})
+}
+
 func (slas *softLayer_Account_Service) GetDatacentersWithSubnetAllocations() ([]datatypes.SoftLayer_Location, error) {
+	return slas.GetDatacentersWithSubnetAllocationsWithContext(context.Background())
+}
+
+func (slas *softLayer_Account_Service) GetDatacentersWithSubnetAllocationsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Location, error) {
 	return []datatypes.SoftLayer_Location{}, nil
 }
 
 func (slas *softLayer_Account_Service) GetHardware() ([]datatypes.SoftLayer_Hardware, error) {
-	path := fmt.Sprintf("%s/%s", slas.GetName(), "getHardware.json")
-	responseBytes, err := slas.client.DoRawHttpRequest(path, "GET", &bytes.Buffer{})
-	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getHardware, error message '%s'", err.Error())
-		return []datatypes.SoftLayer_Hardware{}, errors.New(errorMessage)
-	}
+	return slas.GetHardwareWithContext(context.Background())
+}
 
-	hardwares := []datatypes.SoftLayer_Hardware{}
-	err = json.Unmarshal(responseBytes, &hardwares)
+func (slas *softLayer_Account_Service) GetHardwareWithContext(ctx context.Context) ([]datatypes.SoftLayer_Hardware, error) {
+	return slas.GetHardwareWithOptions(ctx, nil)
+}
 
+func (slas *softLayer_Account_Service) GetHardwareWithOptions(ctx context.Context, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Hardware, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getHardware.json")
+	hardwares := []datatypes.SoftLayer_Hardware{}
+	err := slas.client.DoHttpRequestWithContext(ctx, path, options, "GET", &bytes.Buffer{}, &hardwares)
 	if err != nil {
-		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
-		err := errors.New(errorMessage)
 		return []datatypes.SoftLayer_Hardware{}, err
 	}
 
 	return hardwares, nil
 }
+
+func (slas *softLayer_Account_Service) GetHardwareIterator(ctx context.Context, options *softlayer.RequestOptions, pagerOptions softlayer.PagerOptions) *softlayer.HardwareIterator {
+	return softlayer.NewHardwareIterator(ctx, options, pagerOptions, func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+		page, err := slas.GetHardwareWithOptions(ctx, options)
+		return page, len(page), err
+	})
+}
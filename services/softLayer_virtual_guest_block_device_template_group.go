@@ -2,8 +2,8 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	datatypes "github.com/maximilien/softlayer-go/data_types"
@@ -25,13 +25,13 @@ func (slvgs *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetNam
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetObject(id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getObject.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
-	}
+	return slvgbdtg.GetObjectWithContext(context.Background(), id)
+}
 
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	path := fmt.Sprintf("%s/%d/getObject.json", slvgbdtg.GetName(), id)
 	vgbdtGroup := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
-	err = json.Unmarshal(response, &vgbdtGroup)
+	err := slvgbdtg.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &vgbdtGroup)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
 	}
@@ -40,13 +40,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) DeleteObject(id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slvgbdtg.GetName(), id), "DELETE", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
-	}
+	return slvgbdtg.DeleteObjectWithContext(context.Background(), id)
+}
 
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) DeleteObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	path := fmt.Sprintf("%s/%d.json", slvgbdtg.GetName(), id)
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
-	err = json.Unmarshal(response, &transaction)
+	err := slvgbdtg.client.DoHttpRequestWithContext(ctx, path, nil, "DELETE", new(bytes.Buffer), &transaction)
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
@@ -55,13 +55,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Del
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetDatacenters(id int) ([]datatypes.SoftLayer_Location, error) {
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getDatacenters.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Location{}, err
-	}
+	return slvgbdtg.GetDatacentersWithContext(context.Background(), id)
+}
 
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetDatacentersWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Location, error) {
+	path := fmt.Sprintf("%s/%d/getDatacenters.json", slvgbdtg.GetName(), id)
 	locations := []datatypes.SoftLayer_Location{}
-	err = json.Unmarshal(response, &locations)
+	err := slvgbdtg.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &locations)
 	if err != nil {
 		return []datatypes.SoftLayer_Location{}, err
 	}
@@ -70,13 +70,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetSshKeys(id int) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getSshKeys.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
-	}
+	return slvgbdtg.GetSshKeysWithContext(context.Background(), id)
+}
 
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetSshKeysWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
+	path := fmt.Sprintf("%s/%d/getSshKeys.json", slvgbdtg.GetName(), id)
 	sshKeys := []datatypes.SoftLayer_Security_Ssh_Key{}
-	err = json.Unmarshal(response, &sshKeys)
+	err := slvgbdtg.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &sshKeys)
 	if err != nil {
 		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
@@ -85,13 +85,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetStatus(id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status, error) {
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getStatus.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status{}, err
-	}
+	return slvgbdtg.GetStatusWithContext(context.Background(), id)
+}
 
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetStatusWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status, error) {
+	path := fmt.Sprintf("%s/%d/getStatus.json", slvgbdtg.GetName(), id)
 	status := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status{}
-	err = json.Unmarshal(response, &status)
+	err := slvgbdtg.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &status)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status{}, err
 	}
@@ -100,13 +100,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetImageType(id int) (datatypes.SoftLayer_Image_Type, error) {
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getImageType.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Image_Type{}, err
-	}
+	return slvgbdtg.GetImageTypeWithContext(context.Background(), id)
+}
 
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetImageTypeWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Image_Type, error) {
+	path := fmt.Sprintf("%s/%d/getImageType.json", slvgbdtg.GetName(), id)
 	imageType := datatypes.SoftLayer_Image_Type{}
-	err = json.Unmarshal(response, &imageType)
+	err := slvgbdtg.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &imageType)
 	if err != nil {
 		return datatypes.SoftLayer_Image_Type{}, err
 	}
@@ -115,13 +115,13 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetStorageLocations(id int) ([]datatypes.SoftLayer_Location, error) {
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getStorageLocations.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Location{}, err
-	}
+	return slvgbdtg.GetStorageLocationsWithContext(context.Background(), id)
+}
 
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetStorageLocationsWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Location, error) {
+	path := fmt.Sprintf("%s/%d/getStorageLocations.json", slvgbdtg.GetName(), id)
 	locations := []datatypes.SoftLayer_Location{}
-	err = json.Unmarshal(response, &locations)
+	err := slvgbdtg.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &locations)
 	if err != nil {
 		return []datatypes.SoftLayer_Location{}, err
 	}
@@ -130,6 +130,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Get
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CreateFromExternalSource(configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	return slvgbdtg.CreateFromExternalSourceWithContext(context.Background(), configuration)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CreateFromExternalSourceWithContext(ctx context.Context, configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
 	parameters := datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration_Parameters{
 		Parameters: []datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration{configuration},
 	}
@@ -139,13 +143,9 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Cre
 		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
 	}
 
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/CreateFromExternalSource.json", slvgbdtg.GetName()), "POST", bytes.NewBuffer(requestBody))
-	if err != nil {
-		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
-	}
-
+	path := fmt.Sprintf("%s/CreateFromExternalSource.json", slvgbdtg.GetName())
 	vgbdtGroup := datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
-	err = json.Unmarshal(response, &vgbdtGroup)
+	err = slvgbdtg.client.DoHttpRequestWithContext(ctx, path, nil, "POST", bytes.NewBuffer(requestBody), &vgbdtGroup)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
 	}
@@ -154,6 +154,10 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Cre
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CopyToExternalSource(configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (bool, error) {
+	return slvgbdtg.CopyToExternalSourceWithContext(context.Background(), configuration)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) CopyToExternalSourceWithContext(ctx context.Context, configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (bool, error) {
 	parameters := datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration_Parameters{
 		Parameters: []datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration{configuration},
 	}
@@ -163,20 +167,36 @@ func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) Cop
 		return false, err
 	}
 
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/CopyToExternalSource.json", slvgbdtg.GetName()), "POST", bytes.NewBuffer(requestBody))
+	path := fmt.Sprintf("%s/CopyToExternalSource.json", slvgbdtg.GetName())
+	response, err := slvgbdtg.client.DoRawHttpRequestWithContext(ctx, path, "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to create virtual guest block device template group, got '%s' as response from the API.", res))
+		return false, newSoftLayerError(slvgbdtg, "copyToExternalSource", path, response, fmt.Sprintf("Failed to create virtual guest block device template group, got '%s' as response from the API.", res))
 	}
 
 	return true, nil
 }
 
 func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetImageTypeKeyName(id int) (string, error) {
-	response, err := slvgbdtg.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/GetImageTypeKeyName.json", slvgbdtg.GetName(), id), "GET", new(bytes.Buffer))
+	return slvgbdtg.GetImageTypeKeyNameWithContext(context.Background(), id)
+}
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) GetImageTypeKeyNameWithContext(ctx context.Context, id int) (string, error) {
+	path := fmt.Sprintf("%s/%d/GetImageTypeKeyName.json", slvgbdtg.GetName(), id)
+	response, err := slvgbdtg.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
 
 	return string(response), err
 }
+
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) TrackTransaction(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options softlayer.OperationOptions) *softlayer.Operation {
+	return slvgbdtg.TrackTransactionWithContext(context.Background(), transaction, options)
+}
+
+// TrackTransactionWithContext follows the transaction returned by DeleteObject
+// until it completes.
+func (slvgbdtg *softLayer_Virtual_Guest_Block_Device_Template_Group_Service) TrackTransactionWithContext(ctx context.Context, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options softlayer.OperationOptions) *softlayer.Operation {
+	return newTransactionOperation(ctx, slvgbdtg.client, transaction, options)
+}
@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -100,6 +101,27 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 		})
 	})
 
+	Context("#TrackTransaction", func() {
+		It("follows the transaction returned by DeleteObject until it completes", func() {
+			transactionResponse, err := common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_deleteObject.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClient.AddRoute("DELETE", "SoftLayer_Virtual_Guest_Block_Device_Template_Group/1234567.json", transactionResponse)
+			fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/1234567/getActiveTransactions.json", []byte("["+string(transactionResponse)+"]"), []byte("[]"))
+
+			transaction, err := vgbdtgService.DeleteObject(1234567)
+			Expect(err).ToNot(HaveOccurred())
+
+			operation := vgbdtgService.TrackTransaction(transaction, softlayer.OperationOptions{PollInterval: time.Millisecond})
+
+			status := <-operation.Statuses()
+			Expect(status.Name).To(Equal("CLOUD_RECLAIM_PREP"))
+			Expect(status.EstimatedRemaining).To(BeNumerically("~", 24*time.Second, time.Second))
+
+			Expect(operation.Wait()).ToNot(HaveOccurred())
+		})
+	})
+
 	Context("#GetDatacenters", func() {
 		BeforeEach(func() {
 			vgbdtGroup.Id = 1234567
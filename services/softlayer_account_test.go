@@ -1,13 +1,18 @@
 package services_test
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	slclient "github.com/maximilien/softlayer-go/client"
 	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
 	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
@@ -70,6 +75,54 @@ var _ = Describe("SoftLayer_Account_Service", func() {
 		})
 	})
 
+	Context("#GetVirtualGuestsWithOptions", func() {
+		BeforeEach(func() {
+			fakeClient.DoRawHttpRequestResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getVirtualGuests.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("passes the object filter to the client", func() {
+			filter := softlayer.NewObjectFilter().
+				Set("virtualGuests.datacenter.name", softlayer.Equals("dal09")).
+				Set("virtualGuests.hostname", softlayer.BeginsWith("web-"))
+
+			virtualGuests, err := accountService.GetVirtualGuestsWithOptions(context.Background(), &softlayer.RequestOptions{Filter: filter})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualGuests).ToNot(BeNil())
+
+			Expect(fakeClient.DoHttpRequestOptions).ToNot(BeNil())
+			Expect(fakeClient.DoHttpRequestOptions.Filter).To(Equal(filter))
+		})
+
+		It("sends no filter by default", func() {
+			_, err := accountService.GetVirtualGuests()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.DoHttpRequestOptions).To(BeNil())
+		})
+	})
+
+	Context("#GetVirtualGuestsIterator", func() {
+		BeforeEach(func() {
+			fakeClient.DoRawHttpRequestResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getVirtualGuests.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("requests pages with a result limit", func() {
+			filter := softlayer.NewObjectFilter().Set("virtualGuests.hostname", softlayer.BeginsWith("web-"))
+			iterator := accountService.GetVirtualGuestsIterator(context.Background(), &softlayer.RequestOptions{Filter: filter}, softlayer.PagerOptions{PageSize: 50})
+
+			virtualGuests := []datatypes.SoftLayer_Virtual_Guest{}
+			for iterator.Next() {
+				virtualGuests = append(virtualGuests, iterator.Value())
+			}
+			Expect(iterator.Err()).ToNot(HaveOccurred())
+			Expect(virtualGuests).ToNot(BeEmpty())
+
+			Expect(fakeClient.DoHttpRequestOptions.ResultLimit).To(Equal(&softlayer.ResultLimit{Offset: 0, Limit: 50}))
+			Expect(fakeClient.DoHttpRequestOptions.Filter).To(Equal(filter))
+		})
+	})
+
 	Context("#GetNetworkStorage", func() {
 		BeforeEach(func() {
 			fakeClient.DoRawHttpRequestResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getNetworkStorage.json")
@@ -96,6 +149,40 @@ var _ = Describe("SoftLayer_Account_Service", func() {
 		})
 	})
 
+	Context("#GetIscsiNetworkStorageWithOptions", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("SoftLayer-Total-Items", "120")
+				w.Write([]byte(`[{"id": 1234567}]`))
+			}))
+
+			client := slclient.NewSoftLayerClient("fake-username", "fake-api-key", slclient.WithEndpoint(server.URL))
+			accountService, err = client.GetSoftLayer_Account_Service()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("reports the total items in the options when the object mask is defaulted", func() {
+			options := &softlayer.RequestOptions{ResultLimit: &softlayer.ResultLimit{Offset: 0, Limit: 50}}
+
+			_, err := accountService.GetIscsiNetworkStorageWithOptions(context.Background(), options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.Mask).To(BeNil())
+			Expect(options.TotalItems).To(Equal(120))
+		})
+
+		It("reports the total items to the pager of the iterator", func() {
+			iterator := accountService.GetIscsiNetworkStorageIterator(context.Background(), nil, softlayer.PagerOptions{PageSize: 50})
+			Expect(iterator.Next()).To(BeTrue())
+			Expect(iterator.TotalItems()).To(Equal(120))
+		})
+	})
+
 	Context("#GetVirtualDiskImages", func() {
 		BeforeEach(func() {
 			fakeClient.DoRawHttpRequestResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getVirtualDiskImages.json")
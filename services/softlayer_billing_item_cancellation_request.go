@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -24,6 +25,10 @@ func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) GetName() str
 }
 
 func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) CreateObject(request datatypes.SoftLayer_Billing_Item_Cancellation_Request) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	return slbicr.CreateObjectWithContext(context.Background(), request)
+}
+
+func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) CreateObjectWithContext(ctx context.Context, request datatypes.SoftLayer_Billing_Item_Cancellation_Request) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
 	parameters := datatypes.SoftLayer_Billing_Item_Cancellation_Request_Parameters{
 		Parameters: []datatypes.SoftLayer_Billing_Item_Cancellation_Request{
 			request,
@@ -35,13 +40,9 @@ func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) CreateObject(
 		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
 	}
 
-	responseBytes, err := slbicr.client.DoRawHttpRequest(fmt.Sprintf("%s/createObject.json", slbicr.GetName()), "POST", bytes.NewBuffer(requestBody))
-	if err != nil {
-		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
-	}
-
+	path := fmt.Sprintf("%s/createObject.json", slbicr.GetName())
 	result := datatypes.SoftLayer_Billing_Item_Cancellation_Request{}
-	err = json.Unmarshal(responseBytes, &result)
+	err = slbicr.client.DoHttpRequestWithContext(ctx, path, nil, "POST", bytes.NewBuffer(requestBody), &result)
 	if err != nil {
 		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
 	}
@@ -0,0 +1,216 @@
+package services_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	common "github.com/maximilien/softlayer-go/common"
+	contracts "github.com/maximilien/softlayer-go/common/contracts"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// fixtureTargets are the types the fixtures of test_fixtures/services decode
+// into, by fixture name. The fixtures that are not JSON map to nil.
+var fixtureTargets = map[string]func() interface{}{
+	"SoftLayer_Account_Service_getAccountStatus.json": func() interface{} { return &datatypes.SoftLayer_Account_Status{} },
+	"SoftLayer_Account_Service_getBlockDeviceTemplateGroups.json": func() interface{} {
+		return &[]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
+	},
+	"SoftLayer_Account_Service_getDatacentersWithSubnetAllocations.json": func() interface{} { return &[]datatypes.SoftLayer_Location{} },
+	"SoftLayer_Account_Service_getHardware.json":                         func() interface{} { return &[]datatypes.SoftLayer_Hardware{} },
+	"SoftLayer_Account_Service_getNetworkStorage.json":                   func() interface{} { return &[]datatypes.SoftLayer_Network_Storage{} },
+	"SoftLayer_Account_Service_getSshKeys.json":                          func() interface{} { return &[]datatypes.SoftLayer_Security_Ssh_Key{} },
+	"SoftLayer_Account_Service_getVirtualDiskImages.json":                func() interface{} { return &[]datatypes.SoftLayer_Virtual_Disk_Image{} },
+	"SoftLayer_Account_Service_getVirtualGuests.json":                    func() interface{} { return &[]datatypes.SoftLayer_Virtual_Guest{} },
+	"SoftLayer_Billing_Item_Cancellation_Request_Service_createObject.json": func() interface{} {
+		return &datatypes.SoftLayer_Billing_Item_Cancellation_Request{}
+	},
+	"SoftLayer_Hardware_Service_createObject.json":          func() interface{} { return &datatypes.SoftLayer_Hardware{} },
+	"SoftLayer_Network_Storage_Service_getIscsiVolume.json": func() interface{} { return &datatypes.SoftLayer_Network_Storage{} },
+	"SoftLayer_Product_Order_placeOrder.json":               func() interface{} { return &datatypes.SoftLayer_Product_Order_Receipt{} },
+	"SoftLayer_Product_Package_getItemPrices.json":          func() interface{} { return &[]datatypes.SoftLayer_Item_Price{} },
+	"SoftLayer_Security_Ssh_Key_Service_createObject.json":  func() interface{} { return &datatypes.SoftLayer_Security_Ssh_Key{} },
+	"SoftLayer_Security_Ssh_Key_Service_getSoftwarePasswords.json": func() interface{} {
+		return &[]datatypes.SoftLayer_Software_Component_Password{}
+	},
+	"SoftLayer_Virtual_Disk_Image_Service_getObject.json":                                   func() interface{} { return &datatypes.SoftLayer_Virtual_Disk_Image{} },
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_copyToExternalSource.json": func() interface{} { return new(bool) },
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_createFromExternalSource.json": func() interface{} {
+		return &datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
+	},
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_deleteObject.json": func() interface{} {
+		return &datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	},
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_getDatacenters.json":      func() interface{} { return &[]datatypes.SoftLayer_Location{} },
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_getImageType.json":        func() interface{} { return &datatypes.SoftLayer_Image_Type{} },
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_getImageTypeKeyName.json": nil,
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_getObject.json": func() interface{} {
+		return &datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}
+	},
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_getSshKeys.json": func() interface{} { return &[]datatypes.SoftLayer_Security_Ssh_Key{} },
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_getStatus.json": func() interface{} {
+		return &datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status{}
+	},
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service_getStorageLocations.json": func() interface{} { return &[]datatypes.SoftLayer_Location{} },
+	"SoftLayer_Virtual_Guest_Service_activatePrivatePort.json":                             func() interface{} { return new(bool) },
+	"SoftLayer_Virtual_Guest_Service_activatePublicPort.json":                              func() interface{} { return new(bool) },
+	"SoftLayer_Virtual_Guest_Service_attachDiskImage.json": func() interface{} {
+		return &datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	},
+	"SoftLayer_Virtual_Guest_Service_captureImage.json": func() interface{} {
+		return &datatypes.SoftLayer_Container_Disk_Image_Capture_Template{}
+	},
+	"SoftLayer_Virtual_Guest_Service_checkHostDiskAvailability.json": func() interface{} { return new(bool) },
+	"SoftLayer_Virtual_Guest_Service_configureMetadataDisk.json": func() interface{} {
+		return &datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	},
+	"SoftLayer_Virtual_Guest_Service_createArchiveTransaction.json": func() interface{} {
+		return &datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	},
+	"SoftLayer_Virtual_Guest_Service_createObject.json": func() interface{} { return &datatypes.SoftLayer_Virtual_Guest{} },
+	"SoftLayer_Virtual_Guest_Service_detachDiskImage.json": func() interface{} {
+		return &datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	},
+	"SoftLayer_Virtual_Guest_Service_editObject.json": func() interface{} { return new(bool) },
+	"SoftLayer_Virtual_Guest_Service_getActiveTransaction.json": func() interface{} {
+		return &datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	},
+	"SoftLayer_Virtual_Guest_Service_getActiveTransactions.json": func() interface{} {
+		return &[]datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	},
+	"SoftLayer_Virtual_Guest_Service_getBlockDevices.json": func() interface{} {
+		return &[]datatypes.SoftLayer_Virtual_Guest_Block_Device{}
+	},
+	"SoftLayer_Virtual_Guest_Service_getNetworkVlans.json":      func() interface{} { return &[]datatypes.SoftLayer_Network_Vlan{} },
+	"SoftLayer_Virtual_Guest_Service_getObject.json":            func() interface{} { return &datatypes.SoftLayer_Virtual_Guest{} },
+	"SoftLayer_Virtual_Guest_Service_getPowerState.json":        func() interface{} { return &datatypes.SoftLayer_Virtual_Guest_Power_State{} },
+	"SoftLayer_Virtual_Guest_Service_getReferenceTags.json":     func() interface{} { return &[]datatypes.SoftLayer_Tag_Reference{} },
+	"SoftLayer_Virtual_Guest_Service_getSshKeys.json":           func() interface{} { return &[]datatypes.SoftLayer_Security_Ssh_Key{} },
+	"SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices.json": func() interface{} { return &[]datatypes.SoftLayer_Item_Price{} },
+	"SoftLayer_Virtual_Guest_Service_getUserData.json":          func() interface{} { return &[]datatypes.SoftLayer_Virtual_Guest_Attribute{} },
+	"SoftLayer_Virtual_Guest_Service_setMetadata.json":          func() interface{} { return new(bool) },
+	"SoftLayer_Virtual_Guest_Service_setTags.json":              func() interface{} { return new(bool) },
+	"SoftLayer_Virtual_Guest_Service_shutdownPrivatePort.json":  func() interface{} { return new(bool) },
+	"SoftLayer_Virtual_Guest_Service_shutdownPublicPort.json":   func() interface{} { return new(bool) },
+}
+
+// unpopulatedFields are the fields of the data types that no fixture
+// populates. A field added to a data type must be populated by a fixture, or
+// listed here when the API does not return it in the calls of the fixtures.
+var unpopulatedFields = []string{
+	"Item.SoftwareDescription",
+	"Item.SoftwareDescriptionId",
+	"Item.UpgradeItemId",
+	"SoftLayer_Billing_Item_Cancellation_Request.ComplexType",
+	"SoftLayer_Billing_Item_Cancellation_Request.Items",
+	"SoftLayer_Hardware.OperatingSystem",
+	"SoftLayer_Item_Price.OnSaleFlag",
+	"SoftLayer_Item_Price.Quantity",
+	"SoftLayer_Network_Storage.AccountId",
+	"SoftLayer_Network_Storage.BillingItem",
+	"SoftLayer_Network_Storage.CreateDate",
+	"SoftLayer_Network_Storage.GuestId",
+	"SoftLayer_Network_Storage.HardwareId",
+	"SoftLayer_Network_Storage.HostId",
+	"SoftLayer_Network_Storage.NasType",
+	"SoftLayer_Network_Storage.Notes",
+	"SoftLayer_Network_Storage.ServiceProviderId",
+	"SoftLayer_Network_Storage.UpgradableFlag",
+	"SoftLayer_Network_Vlan.NetworkVrfId",
+	"SoftLayer_Network_Vlan.Note",
+	"SoftLayer_Provisioning_Version1_Transaction.HardwareId",
+	"SoftLayer_Security_Ssh_Key.ModifyDate",
+	"SoftLayer_Software_Component_Password.Notes",
+	"SoftLayer_Software_Component_Password.Port",
+	"SoftLayer_Tag_Reference.EmpRecordId",
+	"SoftLayer_Virtual_Disk_Image.Checksum",
+	"SoftLayer_Virtual_Disk_Image.ModifyDate",
+	"SoftLayer_Virtual_Disk_Image.ParentId",
+	"SoftLayer_Virtual_Guest.Datacenter",
+	"SoftLayer_Virtual_Guest.LastPowerStateId",
+	"SoftLayer_Virtual_Guest.LastVerifiedDate",
+	"SoftLayer_Virtual_Guest.ManagedResourceFlag",
+	"SoftLayer_Virtual_Guest.MetricPollDate",
+	"SoftLayer_Virtual_Guest.Notes",
+	"SoftLayer_Virtual_Guest.PostInstallScriptUri",
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group.TransactionId",
+	"SoftLayer_Virtual_Guest_Block_Device_Template_Group_Accounts.Group",
+	"Software.HardwareId",
+}
+
+// objectMaskCalls are the calls sending a default object mask, in addition to
+// the serviceCalls.
+var objectMaskCalls = []serviceCall{
+	{name: "SoftLayer_Account#GetIscsiNetworkStorage", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Account_Service()
+		return service.GetIscsiNetworkStorage()
+	}},
+	{name: "SoftLayer_Virtual_Guest#GetBlockDevices", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Virtual_Guest_Service()
+		return service.GetBlockDevices(1234567)
+	}},
+}
+
+var _ = Describe("Service contracts", func() {
+	Context("fixtures", func() {
+		It("cover every fixture of test_fixtures/services", func() {
+			wd, _ := os.Getwd()
+			paths, err := filepath.Glob(filepath.Join(wd, "..", "test_fixtures", "services", "*.json"))
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, path := range paths {
+				Expect(fixtureTargets).To(HaveKey(filepath.Base(path)), "add the type %s decodes into to fixtureTargets", filepath.Base(path))
+			}
+			Expect(fixtureTargets).To(HaveLen(len(paths)))
+		})
+
+		It("decode into their types without unknown fields", func() {
+			checker := contracts.NewChecker()
+
+			for fixture, target := range fixtureTargets {
+				if target == nil {
+					continue
+				}
+
+				content, err := common.ReadJsonTestFixtures("services", fixture)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = checker.CheckFixture(content, target())
+				Expect(err).ToNot(HaveOccurred(), "%s does not match its type", fixture)
+			}
+
+			Expect(checker.UnpopulatedFields()).To(Equal(unpopulatedFields), "populate the new fields in a fixture or list them in unpopulatedFields")
+		})
+	})
+
+	Context("object masks", func() {
+		It("only reference the properties of the returned types", func() {
+			maskedRequests := 0
+			for _, serviceCall := range append(serviceCalls, objectMaskCalls...) {
+				fakeClient := slclientfakes.NewFakeSoftLayerClient("fake-username", "fake-api-key")
+				fakeClient.AddRoute("", "*", []byte("null"))
+				fakeClient.AddRoute("", "*/*", []byte("null"))
+				fakeClient.AddRoute("", "*/*/*", []byte("null"))
+
+				result, _ := serviceCall.call(fakeClient)
+
+				for _, request := range fakeClient.Requests {
+					if request.Options == nil || request.Options.Mask.IsEmpty() {
+						continue
+					}
+
+					maskedRequests++
+					err := contracts.CheckObjectMask(request.Options.Mask, result)
+					Expect(err).ToNot(HaveOccurred(), "the object mask of %s references unknown properties", serviceCall.name)
+				}
+			}
+
+			Expect(maskedRequests).To(BeNumerically(">=", 4))
+		})
+	})
+})
@@ -0,0 +1,180 @@
+package services_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclient "github.com/maximilien/softlayer-go/client"
+	faults "github.com/maximilien/softlayer-go/client/faults"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+// serviceCall calls a method of a service and returns its result.
+type serviceCall struct {
+	name string
+	call func(client softlayer.Client) (interface{}, error)
+
+	// rawBoolean calls check the response body is true or false themselves
+	// instead of decoding it.
+	rawBoolean bool
+}
+
+var serviceCalls = []serviceCall{
+	{name: "SoftLayer_Account#GetVirtualGuests", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Account_Service()
+		return service.GetVirtualGuests()
+	}},
+	{name: "SoftLayer_Virtual_Guest#GetObject", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Virtual_Guest_Service()
+		return service.GetObject(1234567)
+	}},
+	{name: "SoftLayer_Virtual_Guest#IsPingable", rawBoolean: true, call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Virtual_Guest_Service()
+		return service.IsPingable(1234567)
+	}},
+	{name: "SoftLayer_Security_Ssh_Key#DeleteObject", rawBoolean: true, call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Security_Ssh_Key_Service()
+		return service.DeleteObject(1234567)
+	}},
+	{name: "SoftLayer_Product_Package#GetItemPrices", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Product_Package_Service()
+		return service.GetItemPrices(0)
+	}},
+	{name: "SoftLayer_Product_Order#PlaceOrder", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Product_Order_Service()
+		return service.PlaceOrder(datatypes.SoftLayer_Product_Order{PackageId: 0})
+	}},
+	{name: "SoftLayer_Network_Storage#GetIscsiVolume", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Network_Storage_Service()
+		return service.GetIscsiVolume(1234567)
+	}},
+	{name: "SoftLayer_Billing_Item_Cancellation_Request#CreateObject", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
+		return service.CreateObject(datatypes.SoftLayer_Billing_Item_Cancellation_Request{})
+	}},
+	{name: "SoftLayer_Virtual_Disk_Image#GetObject", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Virtual_Disk_Image_Service()
+		return service.GetObject(1234567)
+	}},
+	{name: "SoftLayer_Virtual_Guest_Block_Device_Template_Group#GetObject", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service()
+		return service.GetObject(1234567)
+	}},
+	{name: "SoftLayer_Hardware#GetObject", call: func(client softlayer.Client) (interface{}, error) {
+		service, _ := client.GetSoftLayer_Hardware_Service()
+		return service.GetObject("1234567")
+	}},
+}
+
+var _ = Describe("Services with faults", func() {
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/getVirtualGuests.json"), strings.HasSuffix(r.URL.Path, "/getItemPrices.json"):
+				w.Write([]byte(`[{"id": 1234567}]`))
+			case strings.HasSuffix(r.URL.Path, "/isPingable.json"), r.Method == "DELETE":
+				w.Write([]byte(`true`))
+			case strings.HasSuffix(r.URL.Path, "/placeOrder.json"):
+				w.Write([]byte(`{"orderId": 1234567}`))
+			default:
+				w.Write([]byte(`{"id": 1234567}`))
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newClient := func(fault faults.Fault) softlayer.Client {
+		return slclient.NewSoftLayerClient("fake-username", "fake-api-key",
+			slclient.WithEndpoint(server.URL),
+			slclient.WithTransport(faults.New(nil, fault)),
+			slclient.WithRetryPolicy(slclient.RetryPolicy{MaxAttempts: 1}))
+	}
+
+	for _, serviceCall := range serviceCalls {
+		serviceCall := serviceCall
+
+		Context(serviceCall.name, func() {
+			It("succeeds, only later, with FAULT_LATENCY", func() {
+				start := time.Now()
+				result, err := serviceCall.call(newClient(faults.Fault{Kind: faults.FAULT_LATENCY, Latency: 20 * time.Millisecond}))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(isEmpty(result)).To(BeFalse())
+				Expect(time.Since(start)).To(BeNumerically(">=", 20*time.Millisecond))
+			})
+
+			It("returns the HTTP 500 with FAULT_SERVER_ERROR", func() {
+				_, err := serviceCall.call(newClient(faults.Fault{Kind: faults.FAULT_SERVER_ERROR}))
+
+				slErr, ok := softlayer.AsSoftLayerError(err)
+				Expect(ok).To(BeTrue())
+				Expect(slErr.StatusCode).To(Equal(500))
+			})
+
+			It("returns a rate limit error with FAULT_TOO_MANY_REQUESTS", func() {
+				_, err := serviceCall.call(newClient(faults.Fault{Kind: faults.FAULT_TOO_MANY_REQUESTS}))
+				Expect(softlayer.IsRateLimited(err)).To(BeTrue())
+			})
+
+			It("returns the exception with FAULT_EXCEPTION", func() {
+				_, err := serviceCall.call(newClient(faults.Fault{
+					Kind:       faults.FAULT_EXCEPTION,
+					StatusCode: 404,
+					Code:       softlayer.SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND,
+					Message:    "Unable to find object with id of '1234567'.",
+				}))
+				Expect(softlayer.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("returns an error with FAULT_TRUNCATED_BODY", func() {
+				_, err := serviceCall.call(newClient(faults.Fault{Kind: faults.FAULT_TRUNCATED_BODY}))
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("returns the transport error with FAULT_DROPPED_CONNECTION", func() {
+				_, err := serviceCall.call(newClient(faults.Fault{Kind: faults.FAULT_DROPPED_CONNECTION}))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(faults.ErrConnectionDropped.Error()))
+			})
+
+			if serviceCall.rawBoolean {
+				It("returns an error with FAULT_NULL_BODY", func() {
+					_, err := serviceCall.call(newClient(faults.Fault{Kind: faults.FAULT_NULL_BODY}))
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("got 'null' as response from the API"))
+				})
+			} else {
+				It("returns an empty result without error with FAULT_NULL_BODY", func() {
+					result, err := serviceCall.call(newClient(faults.Fault{Kind: faults.FAULT_NULL_BODY}))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(isEmpty(result)).To(BeTrue())
+				})
+			}
+
+			It("returns an error with FAULT_EMPTY_BODY", func() {
+				_, err := serviceCall.call(newClient(faults.Fault{Kind: faults.FAULT_EMPTY_BODY}))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	}
+})
+
+func isEmpty(result interface{}) bool {
+	value := reflect.ValueOf(result)
+	if value.Kind() == reflect.Slice {
+		return value.Len() == 0
+	}
+
+	return reflect.DeepEqual(result, reflect.Zero(value.Type()).Interface())
+}
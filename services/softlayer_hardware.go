@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -24,6 +25,10 @@ func (slhs *softLayer_Hardware_Service) GetName() string {
 }
 
 func (slhs *softLayer_Hardware_Service) CreateObject(template datatypes.SoftLayer_Hardware_Template) (datatypes.SoftLayer_Hardware, error) {
+	return slhs.CreateObjectWithContext(context.Background(), template)
+}
+
+func (slhs *softLayer_Hardware_Service) CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Hardware_Template) (datatypes.SoftLayer_Hardware, error) {
 	parameters := datatypes.SoftLayer_Hardware_Template_Parameters{
 		Parameters: []datatypes.SoftLayer_Hardware_Template{
 			template,
@@ -35,7 +40,8 @@ func (slhs *softLayer_Hardware_Service) CreateObject(template datatypes.SoftLaye
 		return datatypes.SoftLayer_Hardware{}, err
 	}
 
-	response, err := slhs.client.DoRawHttpRequest(fmt.Sprintf("%s.json", slhs.GetName()), "POST", bytes.NewBuffer(requestBody))
+	path := fmt.Sprintf("%s.json", slhs.GetName())
+	response, err := slhs.client.DoRawHttpRequestWithContext(ctx, path, "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Hardware{}, err
 	}
@@ -48,14 +54,21 @@ func (slhs *softLayer_Hardware_Service) CreateObject(template datatypes.SoftLaye
 	bare_metal_server := datatypes.SoftLayer_Hardware{}
 	err = json.Unmarshal(response, &bare_metal_server)
 	if err != nil {
-		return datatypes.SoftLayer_Hardware{}, err
+		return datatypes.SoftLayer_Hardware{}, newInvalidJsonResponseError(slhs, "createObject", path, response, err)
 	}
 
 	return bare_metal_server, nil
 }
 
 func (slhs *softLayer_Hardware_Service) GetObject(id string) (datatypes.SoftLayer_Hardware, error) {
+	return slhs.GetObjectWithContext(context.Background(), id)
+}
 
+func (slhs *softLayer_Hardware_Service) GetObjectWithContext(ctx context.Context, id string) (datatypes.SoftLayer_Hardware, error) {
+	return slhs.GetObjectWithOptions(ctx, id, nil)
+}
+
+func (slhs *softLayer_Hardware_Service) GetObjectWithOptions(ctx context.Context, id string, options *softlayer.RequestOptions) (datatypes.SoftLayer_Hardware, error) {
 	objectMask := []string{
 		"bareMetalInstanceFlag",
 		"domain",
@@ -69,18 +82,9 @@ func (slhs *softLayer_Hardware_Service) GetObject(id string) (datatypes.SoftLaye
 		"operatingSystem.passwords.username",
 	}
 
-	response, err := slhs.client.DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%s.json", slhs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Hardware{}, err
-	}
-
-	err = slhs.client.CheckForHttpResponseErrors(response)
-	if err != nil {
-		return datatypes.SoftLayer_Hardware{}, err
-	}
-
+	path := fmt.Sprintf("%s/%s.json", slhs.GetName(), id)
 	bare_metal_server := datatypes.SoftLayer_Hardware{}
-	err = json.Unmarshal(response, &bare_metal_server)
+	err := doWithDefaultObjectMask(ctx, slhs.client, path, options, objectMask, &bare_metal_server)
 	if err != nil {
 		return datatypes.SoftLayer_Hardware{}, err
 	}
@@ -2,7 +2,7 @@ package services
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -34,11 +34,15 @@ func (slns *softLayer_Network_Storage_Service) GetName() string {
 }
 
 func (slns *softLayer_Network_Storage_Service) CreateIscsiVolume(size int, location string) (datatypes.SoftLayer_Network_Storage, error) {
+	return slns.CreateIscsiVolumeWithContext(context.Background(), size, location)
+}
+
+func (slns *softLayer_Network_Storage_Service) CreateIscsiVolumeWithContext(ctx context.Context, size int, location string) (datatypes.SoftLayer_Network_Storage, error) {
 	if size < 0 {
 		return datatypes.SoftLayer_Network_Storage{}, errors.New("Cannot create negative sized volumes")
 	}
 
-	iscsiVolumeItemId, err := slns.getIscsiVolumeItemIdBasedOnSize(size)
+	iscsiVolumeItemId, err := slns.getIscsiVolumeItemIdBasedOnSize(ctx, size)
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage{}, err
 	}
@@ -54,8 +58,12 @@ func (slns *softLayer_Network_Storage_Service) CreateIscsiVolume(size int, locat
 		PackageId: NETWORK_STORAGE_PACKAGE_ID,
 	}
 
-	productOrderService, _ := slns.client.GetSoftLayer_Product_Order_Service()
-	receipt, err := productOrderService.PlaceOrder(order)
+	productOrderService, err := slns.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	receipt, err := productOrderService.PlaceOrderWithContext(ctx, order)
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage{}, err
 	}
@@ -63,22 +71,37 @@ func (slns *softLayer_Network_Storage_Service) CreateIscsiVolume(size int, locat
 	var iscsiStorage datatypes.SoftLayer_Network_Storage
 
 	for i := 0; i < CREATE_ISCSI_VOLUME_MAX_RETRY_TIME; i++ {
-		iscsiStorage, err = slns.findIscsiVolumeIdByOrderId(receipt.OrderId)
+		iscsiStorage, err = slns.findIscsiVolumeIdByOrderId(ctx, receipt.OrderId)
 		if err == nil {
 			break
 		} else if i == CREATE_ISCSI_VOLUME_MAX_RETRY_TIME-1 {
 			return datatypes.SoftLayer_Network_Storage{}, err
 		}
 
-		time.Sleep(CREATE_ISCSI_VOLUME_CHECK_INTERVAL * time.Second)
+		select {
+		case <-ctx.Done():
+			return datatypes.SoftLayer_Network_Storage{}, ctx.Err()
+		case <-time.After(CREATE_ISCSI_VOLUME_CHECK_INTERVAL * time.Second):
+		}
 	}
 
 	return iscsiStorage, nil
 }
 
 func (slns *softLayer_Network_Storage_Service) DeleteIscsiVolume(volumeId int, immediateCancellationFlag bool) error {
-	accountService, _ := slns.client.GetSoftLayer_Account_Service()
-	iscsiStorages, _ := accountService.GetIscsiNetworkStorage()
+	return slns.DeleteIscsiVolumeWithContext(context.Background(), volumeId, immediateCancellationFlag)
+}
+
+func (slns *softLayer_Network_Storage_Service) DeleteIscsiVolumeWithContext(ctx context.Context, volumeId int, immediateCancellationFlag bool) error {
+	accountService, err := slns.client.GetSoftLayer_Account_Service()
+	if err != nil {
+		return err
+	}
+
+	iscsiStorages, err := accountService.GetIscsiNetworkStorageWithContext(ctx)
+	if err != nil {
+		return err
+	}
 
 	var accountId, billingItemId int
 
@@ -101,8 +124,12 @@ func (slns *softLayer_Network_Storage_Service) DeleteIscsiVolume(volumeId int, i
 		},
 	}
 
-	billingItemCancellationRequestService, _ := slns.client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
-	_, err := billingItemCancellationRequestService.CreateObject(billingItemCancellationRequest)
+	billingItemCancellationRequestService, err := slns.client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
+	if err != nil {
+		return err
+	}
+
+	_, err = billingItemCancellationRequestService.CreateObjectWithContext(ctx, billingItemCancellationRequest)
 	if err != nil {
 		return err
 	}
@@ -111,13 +138,13 @@ func (slns *softLayer_Network_Storage_Service) DeleteIscsiVolume(volumeId int, i
 }
 
 func (slns *softLayer_Network_Storage_Service) GetIscsiVolume(volumeId int) (datatypes.SoftLayer_Network_Storage, error) {
-	response, err := slns.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getObject.json", slns.GetName(), volumeId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Network_Storage{}, err
-	}
+	return slns.GetIscsiVolumeWithContext(context.Background(), volumeId)
+}
 
+func (slns *softLayer_Network_Storage_Service) GetIscsiVolumeWithContext(ctx context.Context, volumeId int) (datatypes.SoftLayer_Network_Storage, error) {
+	path := fmt.Sprintf("%s/%d/getObject.json", slns.GetName(), volumeId)
 	volume := datatypes.SoftLayer_Network_Storage{}
-	err = json.Unmarshal(response, &volume)
+	err := slns.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &volume)
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage{}, err
 	}
@@ -127,9 +154,16 @@ func (slns *softLayer_Network_Storage_Service) GetIscsiVolume(volumeId int) (dat
 
 // Private methods
 
-func (slns *softLayer_Network_Storage_Service) findIscsiVolumeIdByOrderId(orderId int) (datatypes.SoftLayer_Network_Storage, error) {
-	accountService, _ := slns.client.GetSoftLayer_Account_Service()
-	iscsiStorages, _ := accountService.GetIscsiNetworkStorage()
+func (slns *softLayer_Network_Storage_Service) findIscsiVolumeIdByOrderId(ctx context.Context, orderId int) (datatypes.SoftLayer_Network_Storage, error) {
+	accountService, err := slns.client.GetSoftLayer_Account_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	iscsiStorages, err := accountService.GetIscsiNetworkStorageWithContext(ctx)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
 
 	for i := 0; i < len(iscsiStorages); i++ {
 		storage := iscsiStorages[i]
@@ -139,16 +173,17 @@ func (slns *softLayer_Network_Storage_Service) findIscsiVolumeIdByOrderId(orderI
 		}
 	}
 
-	return datatypes.SoftLayer_Network_Storage{}, errors.New(fmt.Sprintf("Can not find an iSCSI volume with order id %d", orderId))
+	path := fmt.Sprintf("%s/%s", accountService.GetName(), "getIscsiNetworkStorage.json")
+	return datatypes.SoftLayer_Network_Storage{}, newNotFoundError(accountService, "getIscsiNetworkStorage", path, fmt.Sprintf("Can not find an iSCSI volume with order id %d", orderId))
 }
 
-func (slns *softLayer_Network_Storage_Service) getIscsiVolumeItemIdBasedOnSize(size int) (int, error) {
+func (slns *softLayer_Network_Storage_Service) getIscsiVolumeItemIdBasedOnSize(ctx context.Context, size int) (int, error) {
 	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
 	if err != nil {
 		return 0, err
 	}
 
-	itemPrices, err := productPackageService.GetItemPrices(NETWORK_STORAGE_PACKAGE_ID)
+	itemPrices, err := productPackageService.GetItemPricesWithContext(ctx, NETWORK_STORAGE_PACKAGE_ID)
 	if err != nil {
 		return 0, err
 	}
@@ -170,7 +205,8 @@ func (slns *softLayer_Network_Storage_Service) getIscsiVolumeItemIdBasedOnSize(s
 	}
 
 	if currentItemId == 0 {
-		return 0, errors.New(fmt.Sprintf("No proper iSCSI volume for size %d", size))
+		path := fmt.Sprintf("%s/%d/getItemPrices.json", productPackageService.GetName(), NETWORK_STORAGE_PACKAGE_ID)
+		return 0, newNotFoundError(productPackageService, "getItemPrices", path, fmt.Sprintf("No proper iSCSI volume for size %d", size))
 	}
 
 	return currentItemId, nil
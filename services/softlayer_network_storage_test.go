@@ -1,7 +1,9 @@
 package services_test
 
 import (
+	"context"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -50,6 +52,31 @@ var _ = Describe("SoftLayer_Network_Storage", func() {
 		})
 	})
 
+	Context("#CreateIscsiVolumeWithContext", func() {
+		BeforeEach(func() {
+			fakeClient.DoRawHttpRequestResponses = [][]byte{
+				[]byte(`[{"id": 123, "item": {"id": 456, "capacity": "20", "description": "20GB iSCSI SAN Storage"}}]`),
+				[]byte(`{"orderId": 123}`),
+				[]byte(`[]`),
+			}
+		})
+
+		It("reports a not found error when no volume is big enough", func() {
+			_, err := networkStorageService.CreateIscsiVolumeWithContext(context.Background(), 40, "fake-location")
+			Expect(err.Error()).To(ContainSubstring("No proper iSCSI volume for size 40"))
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("stops waiting for the ordered volume when the context is done", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, err := networkStorageService.CreateIscsiVolumeWithContext(ctx, 20, "fake-location")
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(fakeClient.DoRawHttpRequestResponseCount).To(Equal(3))
+		})
+	})
+
 	Context("#GetIscsiVolume", func() {
 		BeforeEach(func() {
 			fakeClient.DoRawHttpRequestResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getIscsiVolume.json")
@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -24,6 +25,10 @@ func (slpo *softLayer_Product_Order_Service) GetName() string {
 }
 
 func (slpo *softLayer_Product_Order_Service) PlaceOrder(order datatypes.SoftLayer_Product_Order) (datatypes.SoftLayer_Product_Order_Receipt, error) {
+	return slpo.PlaceOrderWithContext(context.Background(), order)
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceOrderWithContext(ctx context.Context, order datatypes.SoftLayer_Product_Order) (datatypes.SoftLayer_Product_Order_Receipt, error) {
 	parameters := datatypes.SoftLayer_Product_Order_Parameters{
 		Parameters: []datatypes.SoftLayer_Product_Order{
 			order,
@@ -35,13 +40,9 @@ func (slpo *softLayer_Product_Order_Service) PlaceOrder(order datatypes.SoftLaye
 		return datatypes.SoftLayer_Product_Order_Receipt{}, err
 	}
 
-	responseBytes, err := slpo.client.DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
-	if err != nil {
-		return datatypes.SoftLayer_Product_Order_Receipt{}, err
-	}
-
+	path := fmt.Sprintf("%s/placeOrder.json", slpo.GetName())
 	receipt := datatypes.SoftLayer_Product_Order_Receipt{}
-	err = json.Unmarshal(responseBytes, &receipt)
+	err = slpo.client.DoHttpRequestWithContext(ctx, path, nil, "POST", bytes.NewBuffer(requestBody), &receipt)
 	if err != nil {
 		return datatypes.SoftLayer_Product_Order_Receipt{}, err
 	}
@@ -1,8 +1,7 @@
 package services
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
 
 	datatypes "github.com/maximilien/softlayer-go/data_types"
@@ -24,13 +23,17 @@ func (slpp *softLayer_Product_Package_Service) GetName() string {
 }
 
 func (slpp *softLayer_Product_Package_Service) GetItemPrices(packageId int) ([]datatypes.SoftLayer_Item_Price, error) {
-	response, err := slpp.client.DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getItemPrices.json", slpp.GetName(), packageId), []string{"id", "item.id", "item.description", "item.capacity"}, "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Item_Price{}, err
-	}
+	return slpp.GetItemPricesWithContext(context.Background(), packageId)
+}
+
+func (slpp *softLayer_Product_Package_Service) GetItemPricesWithContext(ctx context.Context, packageId int) ([]datatypes.SoftLayer_Item_Price, error) {
+	return slpp.GetItemPricesWithOptions(ctx, packageId, nil)
+}
 
+func (slpp *softLayer_Product_Package_Service) GetItemPricesWithOptions(ctx context.Context, packageId int, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Item_Price, error) {
+	path := fmt.Sprintf("%s/%d/getItemPrices.json", slpp.GetName(), packageId)
 	itemPrices := []datatypes.SoftLayer_Item_Price{}
-	err = json.Unmarshal(response, &itemPrices)
+	err := doWithDefaultObjectMask(ctx, slpp.client, path, options, []string{"id", "item.id", "item.description", "item.capacity"}, &itemPrices)
 	if err != nil {
 		return []datatypes.SoftLayer_Item_Price{}, err
 	}
@@ -2,8 +2,8 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	datatypes "github.com/maximilien/softlayer-go/data_types"
@@ -25,6 +25,10 @@ func (slssks *softLayer_Security_Ssh_Key_Service) GetName() string {
 }
 
 func (slssks *softLayer_Security_Ssh_Key_Service) CreateObject(template datatypes.SoftLayer_Security_Ssh_Key) (datatypes.SoftLayer_Security_Ssh_Key, error) {
+	return slssks.CreateObjectWithContext(context.Background(), template)
+}
+
+func (slssks *softLayer_Security_Ssh_Key_Service) CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Security_Ssh_Key) (datatypes.SoftLayer_Security_Ssh_Key, error) {
 	parameters := datatypes.SoftLayer_Shh_Key_Parameters{
 		Parameters: []datatypes.SoftLayer_Security_Ssh_Key{
 			template,
@@ -36,7 +40,8 @@ func (slssks *softLayer_Security_Ssh_Key_Service) CreateObject(template datatype
 		return datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
 
-	data, err := slssks.client.DoRawHttpRequest(fmt.Sprintf("%s/createObject", slssks.GetName()), "POST", bytes.NewBuffer(requestBody))
+	path := fmt.Sprintf("%s/createObject", slssks.GetName())
+	data, err := slssks.client.DoRawHttpRequestWithContext(ctx, path, "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
@@ -49,30 +54,38 @@ func (slssks *softLayer_Security_Ssh_Key_Service) CreateObject(template datatype
 	softLayer_Ssh_Key := datatypes.SoftLayer_Security_Ssh_Key{}
 	err = json.Unmarshal(data, &softLayer_Ssh_Key)
 	if err != nil {
-		return datatypes.SoftLayer_Security_Ssh_Key{}, err
+		return datatypes.SoftLayer_Security_Ssh_Key{}, newInvalidJsonResponseError(slssks, "createObject", path, data, err)
 	}
 
 	return softLayer_Ssh_Key, nil
 }
 
 func (slssks *softLayer_Security_Ssh_Key_Service) DeleteObject(sshKeyId int) (bool, error) {
-	response, err := slssks.client.DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slssks.GetName(), sshKeyId), "DELETE", new(bytes.Buffer))
+	return slssks.DeleteObjectWithContext(context.Background(), sshKeyId)
+}
+
+func (slssks *softLayer_Security_Ssh_Key_Service) DeleteObjectWithContext(ctx context.Context, sshKeyId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d.json", slssks.GetName(), sshKeyId)
+	response, err := slssks.client.DoRawHttpRequestWithContext(ctx, path, "DELETE", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to destroy ssh key with id '%d', got '%s' as response from the API.", sshKeyId, res))
+		return false, newSoftLayerError(slssks, "deleteObject", path, response, fmt.Sprintf("Failed to destroy ssh key with id '%d', got '%s' as response from the API.", sshKeyId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slssks *softLayer_Security_Ssh_Key_Service) GetSoftwarePasswords(sshKeyId int) ([]datatypes.SoftLayer_Software_Component_Password, error) {
-	response, err := slssks.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getSoftwarePasswords.json", slssks.GetName(), sshKeyId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Software_Component_Password{}, err
-	}
+	return slssks.GetSoftwarePasswordsWithContext(context.Background(), sshKeyId)
+}
 
+func (slssks *softLayer_Security_Ssh_Key_Service) GetSoftwarePasswordsWithContext(ctx context.Context, sshKeyId int) ([]datatypes.SoftLayer_Software_Component_Password, error) {
+	path := fmt.Sprintf("%s/%d/getSoftwarePasswords.json", slssks.GetName(), sshKeyId)
 	passwords := []datatypes.SoftLayer_Software_Component_Password{}
-	err = json.Unmarshal(response, &passwords)
+	err := slssks.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &passwords)
 	if err != nil {
 		return []datatypes.SoftLayer_Software_Component_Password{}, err
 	}
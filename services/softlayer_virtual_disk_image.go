@@ -2,7 +2,7 @@ package services
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
 
 	datatypes "github.com/maximilien/softlayer-go/data_types"
@@ -24,13 +24,13 @@ func (slvdi *softLayer_Virtual_Disk_Image_Service) GetName() string {
 }
 
 func (slvdi *softLayer_Virtual_Disk_Image_Service) GetObject(vdImageId int) (datatypes.SoftLayer_Virtual_Disk_Image, error) {
-	response, err := slvdi.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getObject.json", slvdi.GetName(), vdImageId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Virtual_Disk_Image{}, err
-	}
+	return slvdi.GetObjectWithContext(context.Background(), vdImageId)
+}
 
+func (slvdi *softLayer_Virtual_Disk_Image_Service) GetObjectWithContext(ctx context.Context, vdImageId int) (datatypes.SoftLayer_Virtual_Disk_Image, error) {
+	path := fmt.Sprintf("%s/%d/getObject.json", slvdi.GetName(), vdImageId)
 	vdImage := datatypes.SoftLayer_Virtual_Disk_Image{}
-	err = json.Unmarshal(response, &vdImage)
+	err := slvdi.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &vdImage)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Disk_Image{}, err
 	}
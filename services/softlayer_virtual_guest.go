@@ -2,10 +2,12 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +18,15 @@ import (
 
 const (
 	EPHEMERAL_DISK_CATEGORY_CODE = "guest_disk1"
+
+	// VIRTUAL_GUEST_PACKAGE_ID is the product package of the virtual guests,
+	// whose os item prices are the operating systems of a reload.
+	VIRTUAL_GUEST_PACKAGE_ID       = 46
+	OPERATING_SYSTEM_CATEGORY_CODE = "os"
+
+	// RELOAD_TOKEN_FORCE reloads the operating system without the
+	// confirmation step of the API.
+	RELOAD_TOKEN_FORCE = "FORCE"
 )
 
 type softLayer_Virtual_Guest_Service struct {
@@ -33,6 +44,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetName() string {
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) CreateObject(template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error) {
+	return slvgs.CreateObjectWithContext(context.Background(), template)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error) {
 	err := slvgs.checkCreateObjectRequiredValues(template)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
@@ -49,7 +64,8 @@ func (slvgs *softLayer_Virtual_Guest_Service) CreateObject(template datatypes.So
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
 
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s.json", slvgs.GetName()), "POST", bytes.NewBuffer(requestBody))
+	path := fmt.Sprintf("%s.json", slvgs.GetName())
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
@@ -62,14 +78,21 @@ func (slvgs *softLayer_Virtual_Guest_Service) CreateObject(template datatypes.So
 	softLayer_Virtual_Guest := datatypes.SoftLayer_Virtual_Guest{}
 	err = json.Unmarshal(response, &softLayer_Virtual_Guest)
 	if err != nil {
-		return datatypes.SoftLayer_Virtual_Guest{}, err
+		return datatypes.SoftLayer_Virtual_Guest{}, newInvalidJsonResponseError(slvgs, "createObject", path, response, err)
 	}
 
 	return softLayer_Virtual_Guest, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetObject(instanceId int) (datatypes.SoftLayer_Virtual_Guest, error) {
+	return slvgs.GetObjectWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetObjectWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest, error) {
+	return slvgs.GetObjectWithOptions(ctx, instanceId, nil)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetObjectWithOptions(ctx context.Context, instanceId int, options *softlayer.RequestOptions) (datatypes.SoftLayer_Virtual_Guest, error) {
 	objectMask := []string{
 		"accountId",
 		"createDate",
@@ -103,13 +126,9 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetObject(instanceId int) (datatyp
 		"operatingSystem.passwords.username",
 	}
 
-	response, err := slvgs.client.DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slvgs.GetName(), instanceId), objectMask, "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Virtual_Guest{}, err
-	}
-
+	path := fmt.Sprintf("%s/%d/getObject.json", slvgs.GetName(), instanceId)
 	virtualGuest := datatypes.SoftLayer_Virtual_Guest{}
-	err = json.Unmarshal(response, &virtualGuest)
+	err := doWithDefaultObjectMask(ctx, slvgs.client, path, options, objectMask, &virtualGuest)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest{}, err
 	}
@@ -118,6 +137,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetObject(instanceId int) (datatyp
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) EditObject(instanceId int, template datatypes.SoftLayer_Virtual_Guest) (bool, error) {
+	return slvgs.EditObjectWithContext(context.Background(), instanceId, template)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) EditObjectWithContext(ctx context.Context, instanceId int, template datatypes.SoftLayer_Virtual_Guest) (bool, error) {
 	parameters := datatypes.SoftLayer_Virtual_Guest_Parameters{
 		Parameters: []datatypes.SoftLayer_Virtual_Guest{template},
 	}
@@ -127,33 +150,45 @@ func (slvgs *softLayer_Virtual_Guest_Service) EditObject(instanceId int, templat
 		return false, err
 	}
 
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	path := fmt.Sprintf("%s/%d/editObject.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to edit virtual guest with id: %d, got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "editObject", path, response, fmt.Sprintf("Failed to edit virtual guest with id: %d, got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) DeleteObject(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slvgs.GetName(), instanceId), "DELETE", new(bytes.Buffer))
+	return slvgs.DeleteObjectWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) DeleteObjectWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "DELETE", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to delete instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "deleteObject", path, response, fmt.Sprintf("Failed to delete instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetPowerState(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getPowerState.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Virtual_Guest_Power_State{}, err
-	}
+	return slvgs.GetPowerStateWithContext(context.Background(), instanceId)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetPowerStateWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error) {
+	path := fmt.Sprintf("%s/%d/getPowerState.json", slvgs.GetName(), instanceId)
 	vgPowerState := datatypes.SoftLayer_Virtual_Guest_Power_State{}
-	err = json.Unmarshal(response, &vgPowerState)
+	err := slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &vgPowerState)
 	if err != nil {
 		return datatypes.SoftLayer_Virtual_Guest_Power_State{}, err
 	}
@@ -162,27 +197,32 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetPowerState(instanceId int) (dat
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryIpAddress(instanceId int) (string, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getPrimaryIpAddress.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.GetPrimaryIpAddressWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryIpAddressWithContext(ctx context.Context, instanceId int) (string, error) {
+	path := fmt.Sprintf("%s/%d/getPrimaryIpAddress.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
 	if err != nil {
 		return "", err
 	}
 
 	vgPrimaryIpAddress := strings.TrimSpace(string(response))
 	if vgPrimaryIpAddress == "" {
-		return "", errors.New(fmt.Sprintf("Failed to get primary IP address for instance with id '%d', got '%s' as response from the API.", instanceId, response))
+		return "", newSoftLayerError(slvgs, "getPrimaryIpAddress", path, response, fmt.Sprintf("Failed to get primary IP address for instance with id '%d', got '%s' as response from the API.", instanceId, response))
 	}
 
 	return vgPrimaryIpAddress, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransaction(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getActiveTransaction.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
-	}
+	return slvgs.GetActiveTransactionWithContext(context.Background(), instanceId)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransactionWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	path := fmt.Sprintf("%s/%d/getActiveTransaction.json", slvgs.GetName(), instanceId)
 	activeTransaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
-	err = json.Unmarshal(response, &activeTransaction)
+	err := slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &activeTransaction)
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
@@ -191,13 +231,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransaction(instanceId in
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransactions(instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getActiveTransactions.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
-	}
+	return slvgs.GetActiveTransactionsWithContext(context.Background(), instanceId)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransactionsWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	path := fmt.Sprintf("%s/%d/getActiveTransactions.json", slvgs.GetName(), instanceId)
 	activeTransactions := []datatypes.SoftLayer_Provisioning_Version1_Transaction{}
-	err = json.Unmarshal(response, &activeTransactions)
+	err := slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &activeTransactions)
 	if err != nil {
 		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
@@ -206,13 +246,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransactions(instanceId i
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetSshKeys(instanceId int) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getSshKeys.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
-	}
+	return slvgs.GetSshKeysWithContext(context.Background(), instanceId)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetSshKeysWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
+	path := fmt.Sprintf("%s/%d/getSshKeys.json", slvgs.GetName(), instanceId)
 	sshKeys := []datatypes.SoftLayer_Security_Ssh_Key{}
-	err = json.Unmarshal(response, &sshKeys)
+	err := slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &sshKeys)
 	if err != nil {
 		return []datatypes.SoftLayer_Security_Ssh_Key{}, err
 	}
@@ -221,76 +261,136 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetSshKeys(instanceId int) ([]data
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) PowerCycle(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/powerCycle.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.PowerCycleWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) PowerCycleWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/powerCycle.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power cycle instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "powerCycle", path, response, fmt.Sprintf("Failed to power cycle instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) PowerOff(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/powerOff.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.PowerOffWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) PowerOffWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/powerOff.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power off instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "powerOff", path, response, fmt.Sprintf("Failed to power off instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) PowerOffSoft(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/powerOffSoft.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.PowerOffSoftWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) PowerOffSoftWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/powerOffSoft.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power off soft instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "powerOffSoft", path, response, fmt.Sprintf("Failed to power off soft instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) PowerOn(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/powerOn.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.PowerOnWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) PowerOnWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/powerOn.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to power on instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "powerOn", path, response, fmt.Sprintf("Failed to power on instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) RebootDefault(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/rebootDefault.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.RebootDefaultWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) RebootDefaultWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/rebootDefault.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to default reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "rebootDefault", path, response, fmt.Sprintf("Failed to default reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) RebootSoft(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/rebootSoft.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.RebootSoftWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) RebootSoftWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/rebootSoft.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to soft reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "rebootSoft", path, response, fmt.Sprintf("Failed to soft reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) RebootHard(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/rebootHard.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.RebootHardWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) RebootHardWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/rebootHard.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to hard reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "rebootHard", path, response, fmt.Sprintf("Failed to hard reboot instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) SetMetadata(instanceId int, metadata string) (bool, error) {
+	return slvgs.SetMetadataWithContext(context.Background(), instanceId, metadata)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) SetMetadataWithContext(ctx context.Context, instanceId int, metadata string) (bool, error) {
 	dataBytes := []byte(metadata)
 	base64EncodedMetadata := base64.StdEncoding.EncodeToString(dataBytes)
 
@@ -305,23 +405,27 @@ func (slvgs *softLayer_Virtual_Guest_Service) SetMetadata(instanceId int, metada
 		return false, err
 	}
 
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/setUserMetadata.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	path := fmt.Sprintf("%s/%d/setUserMetadata.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to setUserMetadata for instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "setUserMetadata", path, response, fmt.Sprintf("Failed to setUserMetadata for instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
-	return true, err
+	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ConfigureMetadataDisk(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/configureMetadataDisk.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
-	}
+	return slvgs.ConfigureMetadataDiskWithContext(context.Background(), instanceId)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) ConfigureMetadataDiskWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	path := fmt.Sprintf("%s/%d/configureMetadataDisk.json", slvgs.GetName(), instanceId)
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
-	err = json.Unmarshal(response, &transaction)
+	err := slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &transaction)
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
@@ -330,13 +434,13 @@ func (slvgs *softLayer_Virtual_Guest_Service) ConfigureMetadataDisk(instanceId i
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetUserData(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Attribute, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getUserData.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Virtual_Guest_Attribute{}, err
-	}
+	return slvgs.GetUserDataWithContext(context.Background(), instanceId)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetUserDataWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Attribute, error) {
+	path := fmt.Sprintf("%s/%d/getUserData.json", slvgs.GetName(), instanceId)
 	attributes := []datatypes.SoftLayer_Virtual_Guest_Attribute{}
-	err = json.Unmarshal(response, &attributes)
+	err := slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &attributes)
 	if err != nil {
 		return []datatypes.SoftLayer_Virtual_Guest_Attribute{}, err
 	}
@@ -345,7 +449,12 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetUserData(instanceId int) ([]dat
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) IsPingable(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/isPingable.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.IsPingableWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) IsPingableWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/isPingable.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
@@ -360,62 +469,32 @@ func (slvgs *softLayer_Virtual_Guest_Service) IsPingable(instanceId int) (bool,
 		return false, nil
 	}
 
-	return false, errors.New(fmt.Sprintf("Failed to checking that virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	return false, newSoftLayerError(slvgs, "isPingable", path, response, fmt.Sprintf("Failed to checking that virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) AttachEphemeralDisk(instanceId int, diskSize int) error {
-	diskItemPrice, err := slvgs.findUpgradeItemPriceForEphemeralDisk(instanceId, diskSize)
-	if err != nil {
-		return err
-	}
+	return slvgs.AttachEphemeralDiskWithContext(context.Background(), instanceId, diskSize)
+}
 
-	service, err := slvgs.client.GetSoftLayer_Product_Order_Service()
+func (slvgs *softLayer_Virtual_Guest_Service) AttachEphemeralDiskWithContext(ctx context.Context, instanceId int, diskSize int) error {
+	diskItemPrice, err := slvgs.findUpgradeItemPriceForEphemeralDisk(ctx, instanceId, diskSize)
 	if err != nil {
 		return err
 	}
 
-	order := datatypes.SoftLayer_Product_Order{
-		VirtualGuests: []datatypes.VirtualGuest{
-			datatypes.VirtualGuest{
-				Id: instanceId,
-			},
-		},
-		Prices: []datatypes.SoftLayer_Item_Price{
-			datatypes.SoftLayer_Item_Price{
-				Id: diskItemPrice.Id,
-				Categories: []datatypes.Category{
-					datatypes.Category{
-						CategoryCode: EPHEMERAL_DISK_CATEGORY_CODE,
-					},
-				},
-			},
-		},
-		ComplexType: "SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade",
-		Properties: []datatypes.Property{
-			datatypes.Property{
-				Name:  "MAINTENANCE_WINDOW",
-				Value: time.Now().UTC().Format(time.RFC3339),
-			},
-			datatypes.Property{
-				Name:  "NOTE_GENERAL",
-				Value: "addingdisks",
-			},
-		},
-	}
-
-	_, err = service.PlaceOrder(order)
+	_, err = slvgs.placeUpgradeOrder(ctx, instanceId, map[string]int{EPHEMERAL_DISK_CATEGORY_CODE: diskItemPrice.Id}, time.Now(), "addingdisks")
 
 	return err
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetUpgradeItemPrices(instanceId int) ([]datatypes.SoftLayer_Item_Price, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getUpgradeItemPrices.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Item_Price{}, err
-	}
+	return slvgs.GetUpgradeItemPricesWithContext(context.Background(), instanceId)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetUpgradeItemPricesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Item_Price, error) {
+	path := fmt.Sprintf("%s/%d/getUpgradeItemPrices.json", slvgs.GetName(), instanceId)
 	itemPrices := []datatypes.SoftLayer_Item_Price{}
-	err = json.Unmarshal(response, &itemPrices)
+	err := slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &itemPrices)
 	if err != nil {
 		return []datatypes.SoftLayer_Item_Price{}, err
 	}
@@ -423,7 +502,76 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetUpgradeItemPrices(instanceId in
 	return itemPrices, nil
 }
 
+func (slvgs *softLayer_Virtual_Guest_Service) ReloadOperatingSystem(instanceId int, config softlayer.ReloadConfig, options softlayer.OperationOptions) (*softlayer.Operation, error) {
+	return slvgs.ReloadOperatingSystemWithContext(context.Background(), instanceId, config, options)
+}
+
+// ReloadOperatingSystemWithContext reinstalls the operating system of the
+// virtual guest, keeping its id and IP addresses. Once the reload is accepted,
+// the returned operation waits for the transaction created for it and follows
+// it until the reload is done. It fails with a TransactionNotStartedError when
+// no new transaction appears within options.StartTimeout.
+func (slvgs *softLayer_Virtual_Guest_Service) ReloadOperatingSystemWithContext(ctx context.Context, instanceId int, config softlayer.ReloadConfig, options softlayer.OperationOptions) (*softlayer.Operation, error) {
+	if config.ImageTemplateGlobalIdentifier != "" && config.OperatingSystemReferenceCode != "" {
+		return nil, errors.New("softlayer-go: an operating system reload takes either an image template or an operating system reference code, not both")
+	}
+
+	configuration := datatypes.SoftLayer_Container_Hardware_Server_Configuration{
+		SshKeyIds:                config.SshKeyIds,
+		PostInstallScriptUri:     config.PostInstallScriptUri,
+		CustomProvisionScriptUri: config.CustomProvisionScriptUri,
+	}
+
+	if config.ImageTemplateGlobalIdentifier != "" {
+		group, err := slvgs.findTemplateGroupByGlobalIdentifier(ctx, config.ImageTemplateGlobalIdentifier)
+		if err != nil {
+			return nil, err
+		}
+
+		configuration.ImageTemplateId = group.Id
+	}
+
+	if config.OperatingSystemReferenceCode != "" {
+		itemPrice, err := slvgs.findOperatingSystemItemPrice(ctx, config.OperatingSystemReferenceCode)
+		if err != nil {
+			return nil, err
+		}
+
+		configuration.ItemPrices = []datatypes.SoftLayer_Item_Price{{Id: itemPrice.Id}}
+	}
+
+	previousTransactionIds, err := slvgs.activeTransactionIds(ctx, instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := datatypes.SoftLayer_Container_Hardware_Server_Configuration_Parameters{
+		Parameters: []interface{}{RELOAD_TOKEN_FORCE, configuration},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%d/reloadOperatingSystem.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, err
+	}
+
+	if res := strings.Trim(string(response), `"`); res != "1" && res != "true" {
+		return nil, newSoftLayerError(slvgs, "reloadOperatingSystem", path, response, fmt.Sprintf("Failed to reload the operating system of instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	}
+
+	return newCreatedTransactionOperation(ctx, slvgs.client, instanceId, previousTransactionIds, options), nil
+}
+
 func (slvgs *softLayer_Virtual_Guest_Service) SetTags(instanceId int, tags []string) (bool, error) {
+	return slvgs.SetTagsWithContext(context.Background(), instanceId, tags)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) SetTagsWithContext(ctx context.Context, instanceId int, tags []string) (bool, error) {
 	var tagStringBuffer bytes.Buffer
 	for i, tag := range tags {
 		tagStringBuffer.WriteString(tag)
@@ -441,26 +589,27 @@ func (slvgs *softLayer_Virtual_Guest_Service) SetTags(instanceId int, tags []str
 		return false, err
 	}
 
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/setTags.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	path := fmt.Sprintf("%s/%d/setTags.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "POST", bytes.NewBuffer(requestBody))
 	if err != nil {
 		return false, err
 	}
 
 	if res := string(response[:]); res != "true" {
-		return false, errors.New(fmt.Sprintf("Failed to setTags for instance with id '%d', got '%s' as response from the API.", instanceId, res))
+		return false, newSoftLayerError(slvgs, "setTags", path, response, fmt.Sprintf("Failed to setTags for instance with id '%d', got '%s' as response from the API.", instanceId, res))
 	}
 
 	return true, nil
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetTagReferences(instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getTagReferences.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Tag_Reference{}, err
-	}
+	return slvgs.GetTagReferencesWithContext(context.Background(), instanceId)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetTagReferencesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error) {
+	path := fmt.Sprintf("%s/%d/getTagReferences.json", slvgs.GetName(), instanceId)
 	tagReferences := []datatypes.SoftLayer_Tag_Reference{}
-	err = json.Unmarshal(response, &tagReferences)
+	err := slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &tagReferences)
 	if err != nil {
 		return []datatypes.SoftLayer_Tag_Reference{}, err
 	}
@@ -469,6 +618,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetTagReferences(instanceId int) (
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) AttachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	return slvgs.AttachDiskImageWithContext(context.Background(), instanceId, imageId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) AttachDiskImageWithContext(ctx context.Context, instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
 	parameters := datatypes.SoftLayer_Virtual_GuestInitParameters{
 		Parameters: datatypes.SoftLayer_Virtual_GuestInitParameter{
 			ImageId: imageId,
@@ -480,13 +633,9 @@ func (slvgs *softLayer_Virtual_Guest_Service) AttachDiskImage(instanceId int, im
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/attachDiskImage.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
-	if err != nil {
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
-	}
-
+	path := fmt.Sprintf("%s/%d/attachDiskImage.json", slvgs.GetName(), instanceId)
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
-	err = json.Unmarshal(response, &transaction)
+	err = slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "POST", bytes.NewBuffer(requestBody), &transaction)
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
@@ -495,6 +644,10 @@ func (slvgs *softLayer_Virtual_Guest_Service) AttachDiskImage(instanceId int, im
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) DetachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	return slvgs.DetachDiskImageWithContext(context.Background(), instanceId, imageId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) DetachDiskImageWithContext(ctx context.Context, instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
 	parameters := datatypes.SoftLayer_Virtual_GuestInitParameters{
 		Parameters: datatypes.SoftLayer_Virtual_GuestInitParameter{
 			ImageId: imageId,
@@ -506,13 +659,9 @@ func (slvgs *softLayer_Virtual_Guest_Service) DetachDiskImage(instanceId int, im
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
 
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/detachDiskImage.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
-	if err != nil {
-		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
-	}
-
+	path := fmt.Sprintf("%s/%d/detachDiskImage.json", slvgs.GetName(), instanceId)
 	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
-	err = json.Unmarshal(response, &transaction)
+	err = slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "POST", bytes.NewBuffer(requestBody), &transaction)
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
@@ -521,7 +670,12 @@ func (slvgs *softLayer_Virtual_Guest_Service) DetachDiskImage(instanceId int, im
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ActivatePrivatePort(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/activatePrivatePort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.ActivatePrivatePortWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ActivatePrivatePortWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/activatePrivatePort.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
@@ -536,11 +690,16 @@ func (slvgs *softLayer_Virtual_Guest_Service) ActivatePrivatePort(instanceId int
 		return false, nil
 	}
 
-	return false, errors.New(fmt.Sprintf("Failed to activate private port for virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	return false, newSoftLayerError(slvgs, "activatePrivatePort", path, response, fmt.Sprintf("Failed to activate private port for virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ActivatePublicPort(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/activatePublicPort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.ActivatePublicPortWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ActivatePublicPortWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/activatePublicPort.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
@@ -555,11 +714,16 @@ func (slvgs *softLayer_Virtual_Guest_Service) ActivatePublicPort(instanceId int)
 		return false, nil
 	}
 
-	return false, errors.New(fmt.Sprintf("Failed to activate public port for virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	return false, newSoftLayerError(slvgs, "activatePublicPort", path, response, fmt.Sprintf("Failed to activate public port for virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPrivatePort(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/shutdownPrivatePort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.ShutdownPrivatePortWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPrivatePortWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/shutdownPrivatePort.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
@@ -574,11 +738,16 @@ func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPrivatePort(instanceId int
 		return false, nil
 	}
 
-	return false, errors.New(fmt.Sprintf("Failed to shutdown private port for virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	return false, newSoftLayerError(slvgs, "shutdownPrivatePort", path, response, fmt.Sprintf("Failed to shutdown private port for virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPublicPort(instanceId int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/shutdownPublicPort.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	return slvgs.ShutdownPublicPortWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPublicPortWithContext(ctx context.Context, instanceId int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/shutdownPublicPort.json", slvgs.GetName(), instanceId)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
@@ -593,17 +762,17 @@ func (slvgs *softLayer_Virtual_Guest_Service) ShutdownPublicPort(instanceId int)
 		return false, nil
 	}
 
-	return false, errors.New(fmt.Sprintf("Failed to shutdown public port for virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	return false, newSoftLayerError(slvgs, "shutdownPublicPort", path, response, fmt.Sprintf("Failed to shutdown public port for virtual guest is pingable for instance with id '%d', got '%s' as response from the API.", instanceId, res))
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetNetworkVlans(instanceId int) ([]datatypes.SoftLayer_Network_Vlan, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/getNetworkVlans.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Network_Vlan{}, err
-	}
+	return slvgs.GetNetworkVlansWithContext(context.Background(), instanceId)
+}
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetNetworkVlansWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Network_Vlan, error) {
+	path := fmt.Sprintf("%s/%d/getNetworkVlans.json", slvgs.GetName(), instanceId)
 	networkVlans := []datatypes.SoftLayer_Network_Vlan{}
-	err = json.Unmarshal(response, &networkVlans)
+	err := slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "GET", new(bytes.Buffer), &networkVlans)
 	if err != nil {
 		return []datatypes.SoftLayer_Network_Vlan{}, err
 	}
@@ -611,8 +780,51 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetNetworkVlans(instanceId int) ([
 	return networkVlans, nil
 }
 
+func (slvgs *softLayer_Virtual_Guest_Service) GetBlockDevices(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device, error) {
+	return slvgs.GetBlockDevicesWithContext(context.Background(), instanceId)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetBlockDevicesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device, error) {
+	return slvgs.GetBlockDevicesWithOptions(ctx, instanceId, nil)
+}
+
+// GetBlockDevicesWithOptions returns the block devices with their disk
+// images, unless options has its own object mask.
+func (slvgs *softLayer_Virtual_Guest_Service) GetBlockDevicesWithOptions(ctx context.Context, instanceId int, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device, error) {
+	objectMask := []string{
+		"bootableFlag",
+		"device",
+		"diskImageId",
+		"guestId",
+		"id",
+		"mountType",
+		"statusId",
+		"uuid",
+
+		"diskImage.capacity",
+		"diskImage.description",
+		"diskImage.id",
+		"diskImage.name",
+		"diskImage.units",
+	}
+
+	path := fmt.Sprintf("%s/%d/getBlockDevices.json", slvgs.GetName(), instanceId)
+	blockDevices := []datatypes.SoftLayer_Virtual_Guest_Block_Device{}
+	err := doWithDefaultObjectMask(ctx, slvgs.client, path, options, objectMask, &blockDevices)
+	if err != nil {
+		return []datatypes.SoftLayer_Virtual_Guest_Block_Device{}, err
+	}
+
+	return blockDevices, nil
+}
+
 func (slvgs *softLayer_Virtual_Guest_Service) CheckHostDiskAvailability(instanceId int, diskCapacity int) (bool, error) {
-	response, err := slvgs.client.DoRawHttpRequest(fmt.Sprintf("%s/%d/checkHostDiskAvailability/%d", slvgs.GetName(), instanceId, diskCapacity), "GET", new(bytes.Buffer))
+	return slvgs.CheckHostDiskAvailabilityWithContext(context.Background(), instanceId, diskCapacity)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CheckHostDiskAvailabilityWithContext(ctx context.Context, instanceId int, diskCapacity int) (bool, error) {
+	path := fmt.Sprintf("%s/%d/checkHostDiskAvailability/%d", slvgs.GetName(), instanceId, diskCapacity)
+	response, err := slvgs.client.DoRawHttpRequestWithContext(ctx, path, "GET", new(bytes.Buffer))
 	if err != nil {
 		return false, err
 	}
@@ -627,10 +839,251 @@ func (slvgs *softLayer_Virtual_Guest_Service) CheckHostDiskAvailability(instance
 		return false, nil
 	}
 
-	return false, errors.New(fmt.Sprintf("Failed to check host disk availability for instance '%d', got '%s' as response from the API.", instanceId, res))
+	return false, newSoftLayerError(slvgs, "checkHostDiskAvailability", path, response, fmt.Sprintf("Failed to check host disk availability for instance '%d', got '%s' as response from the API.", instanceId, res))
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CreateArchiveTransaction(instanceId int, groupName string, blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device, note string) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	return slvgs.CreateArchiveTransactionWithContext(context.Background(), instanceId, groupName, blockDevices, note)
+}
+
+// CreateArchiveTransactionWithContext captures the block devices, e.g. the
+// ones of GetBlockDevices without the swap disk, as a standard image template
+// named groupName. It returns the template group of the image, which is
+// ACTIVE once the archive transaction completes.
+func (slvgs *softLayer_Virtual_Guest_Service) CreateArchiveTransactionWithContext(ctx context.Context, instanceId int, groupName string, blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device, note string) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	if groupName == "" {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New("softlayer-go: the name of the image template is required")
+	}
+
+	if len(blockDevices) == 0 {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New("softlayer-go: at least one block device is required to create an image template")
+	}
+
+	blockDeviceIds := []datatypes.SoftLayer_Virtual_Guest_Block_Device{}
+	for _, blockDevice := range blockDevices {
+		blockDeviceIds = append(blockDeviceIds, datatypes.SoftLayer_Virtual_Guest_Block_Device{Id: blockDevice.Id})
+	}
+
+	parameters := datatypes.SoftLayer_Virtual_Guest_CreateArchiveTransaction_Parameters{
+		Parameters: []interface{}{groupName, blockDeviceIds, note},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	path := fmt.Sprintf("%s/%d/createArchiveTransaction.json", slvgs.GetName(), instanceId)
+	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	err = slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "POST", bytes.NewBuffer(requestBody), &transaction)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	return slvgs.findTemplateGroup(ctx, instanceId, groupName, []int{transaction.Id})
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CaptureImage(instanceId int, template datatypes.SoftLayer_Container_Disk_Image_Capture_Template, options softlayer.OperationOptions) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	return slvgs.CaptureImageWithContext(context.Background(), instanceId, template, options)
+}
+
+// CaptureImageWithContext captures the volumes of template as a flex image
+// and returns its template group: the one named as the captured template and
+// created by a transaction of the virtual guest started by the capture. The
+// group is polled every options.PollInterval until options.StartTimeout.
+func (slvgs *softLayer_Virtual_Guest_Service) CaptureImageWithContext(ctx context.Context, instanceId int, template datatypes.SoftLayer_Container_Disk_Image_Capture_Template, options softlayer.OperationOptions) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	if template.Name == "" {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, errors.New("softlayer-go: the name of the image template is required")
+	}
+
+	previousTransactionIds, err := slvgs.activeTransactionIds(ctx, instanceId)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	parameters := datatypes.SoftLayer_Container_Disk_Image_Capture_Template_Parameters{
+		Parameters: []datatypes.SoftLayer_Container_Disk_Image_Capture_Template{template},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	path := fmt.Sprintf("%s/%d/captureImage.json", slvgs.GetName(), instanceId)
+	captured := datatypes.SoftLayer_Container_Disk_Image_Capture_Template{}
+	err = slvgs.client.DoHttpRequestWithContext(ctx, path, nil, "POST", bytes.NewBuffer(requestBody), &captured)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	if captured.Name == "" {
+		captured.Name = template.Name
+	}
+
+	return slvgs.waitForTemplateGroup(ctx, instanceId, captured.Name, previousTransactionIds, options)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) TrackTransaction(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options softlayer.OperationOptions) *softlayer.Operation {
+	return slvgs.TrackTransactionWithContext(context.Background(), transaction, options)
+}
+
+// TrackTransactionWithContext follows a transaction returned by e.g.
+// AttachDiskImage or ConfigureMetadataDisk until it completes.
+func (slvgs *softLayer_Virtual_Guest_Service) TrackTransactionWithContext(ctx context.Context, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options softlayer.OperationOptions) *softlayer.Operation {
+	return newTransactionOperation(ctx, slvgs.client, transaction, options)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) Upgrade(instanceId int, options softlayer.UpgradeOptions) (datatypes.SoftLayer_Product_Order_Receipt, error) {
+	return slvgs.UpgradeWithContext(context.Background(), instanceId, options)
+}
+
+// UpgradeWithContext orders all the upgrades of options at once, after
+// checking that their capacities are in the upgrade item prices of the
+// virtual guest.
+func (slvgs *softLayer_Virtual_Guest_Service) UpgradeWithContext(ctx context.Context, instanceId int, options softlayer.UpgradeOptions) (datatypes.SoftLayer_Product_Order_Receipt, error) {
+	capacities, err := upgradeCapacities(options)
+	if err != nil {
+		return datatypes.SoftLayer_Product_Order_Receipt{}, err
+	}
+
+	itemPrices, err := slvgs.GetUpgradeItemPricesWithContext(ctx, instanceId)
+	if err != nil {
+		return datatypes.SoftLayer_Product_Order_Receipt{}, err
+	}
+
+	priceIds := map[string]int{}
+	for _, categoryCode := range sortedCategoryCodes(capacities) {
+		itemPrice, err := slvgs.findUpgradeItemPrice(instanceId, itemPrices, categoryCode, capacities[categoryCode])
+		if err != nil {
+			return datatypes.SoftLayer_Product_Order_Receipt{}, err
+		}
+
+		priceIds[categoryCode] = itemPrice.Id
+	}
+
+	maintenanceWindow := options.MaintenanceWindow
+	if maintenanceWindow.IsZero() {
+		maintenanceWindow = time.Now()
+	}
+
+	return slvgs.placeUpgradeOrder(ctx, instanceId, priceIds, maintenanceWindow, "upgrade")
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) WaitUntilReady(instanceId int, options softlayer.ReadyOptions) error {
+	return slvgs.WaitUntilReadyWithContext(context.Background(), instanceId, options)
+}
+
+// WaitUntilReadyWithContext polls the virtual guest until it has no active
+// transactions, is running, has a primary IP address and, when asked, is
+// pingable. It returns a *softlayer.NotReadyError naming the state the
+// virtual guest was left in when it is not ready in time.
+func (slvgs *softLayer_Virtual_Guest_Service) WaitUntilReadyWithContext(ctx context.Context, instanceId int, options softlayer.ReadyOptions) error {
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = softlayer.DEFAULT_READY_TIMEOUT
+	}
+
+	pollInterval := options.PollInterval
+	if pollInterval == 0 {
+		pollInterval = softlayer.DEFAULT_READY_POLL_INTERVAL
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	lastState := softlayer.ReadyState{}
+	for {
+		state, err := slvgs.readyState(waitCtx, instanceId, options.Pingable)
+		if err == nil {
+			state.Elapsed = time.Since(start)
+			lastState = state
+
+			if options.Progress != nil {
+				options.Progress(state)
+			}
+
+			if state.Ready {
+				return nil
+			}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if waitCtx.Err() != nil {
+			return &softlayer.NotReadyError{InstanceId: instanceId, Timeout: timeout, State: lastState}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-time.After(pollInterval):
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return &softlayer.NotReadyError{InstanceId: instanceId, Timeout: timeout, State: lastState}
+		}
+	}
 }
 
 //Private methods
+func (slvgs *softLayer_Virtual_Guest_Service) readyState(ctx context.Context, instanceId int, pingable bool) (softlayer.ReadyState, error) {
+	state := softlayer.ReadyState{}
+
+	activeTransactions, err := slvgs.GetActiveTransactionsWithContext(ctx, instanceId)
+	if err != nil {
+		return state, err
+	}
+
+	state.ActiveTransactions = activeTransactions
+	if len(activeTransactions) > 0 {
+		return state, nil
+	}
+
+	powerState, err := slvgs.GetPowerStateWithContext(ctx, instanceId)
+	if err != nil {
+		return state, err
+	}
+
+	state.PowerState = powerState.KeyName
+	if state.PowerState != softlayer.POWER_STATE_RUNNING {
+		return state, nil
+	}
+
+	state.PrimaryIpAddress, err = slvgs.GetPrimaryIpAddressWithContext(ctx, instanceId)
+	if err != nil {
+		slErr, ok := softlayer.AsSoftLayerError(err)
+		if !ok || slErr.StatusCode != 0 || slErr.Err != nil {
+			return state, err
+		}
+
+		// The API answered without an address, it is not assigned yet.
+		return state, nil
+	}
+
+	if pingable {
+		state.Pingable, err = slvgs.IsPingableWithContext(ctx, instanceId)
+		if err != nil {
+			return state, err
+		}
+
+		if !state.Pingable {
+			return state, nil
+		}
+	}
+
+	state.Ready = true
+
+	return state, nil
+}
+
 func (slvgs *softLayer_Virtual_Guest_Service) checkCreateObjectRequiredValues(template datatypes.SoftLayer_Virtual_Guest_Template) error {
 	var err error
 	errorMessage, errorTemplate := "", "* %s is required and cannot be empty\n"
@@ -668,14 +1121,14 @@ func (slvgs *softLayer_Virtual_Guest_Service) checkCreateObjectRequiredValues(te
 	return err
 }
 
-func (slvgs *softLayer_Virtual_Guest_Service) findUpgradeItemPriceForEphemeralDisk(instanceId int, ephemeralDiskSize int) (datatypes.SoftLayer_Item_Price, error) {
+func (slvgs *softLayer_Virtual_Guest_Service) findUpgradeItemPriceForEphemeralDisk(ctx context.Context, instanceId int, ephemeralDiskSize int) (datatypes.SoftLayer_Item_Price, error) {
 	if ephemeralDiskSize <= 0 {
 		return datatypes.SoftLayer_Item_Price{}, errors.New(fmt.Sprintf("Ephemeral disk size can not be negative: %d", ephemeralDiskSize))
 	}
 
-	itemPrices, err := slvgs.GetUpgradeItemPrices(instanceId)
+	itemPrices, err := slvgs.GetUpgradeItemPricesWithContext(ctx, instanceId)
 	if err != nil {
-		return datatypes.SoftLayer_Item_Price{}, nil
+		return datatypes.SoftLayer_Item_Price{}, err
 	}
 
 	var currentDiskCapacity int
@@ -710,3 +1163,308 @@ func (slvgs *softLayer_Virtual_Guest_Service) findUpgradeItemPriceForEphemeralDi
 
 	return currentItemPrice, nil
 }
+
+// findTemplateGroup returns the template group named groupName created by one
+// of the transactions of the virtual guest.
+func (slvgs *softLayer_Virtual_Guest_Service) findTemplateGroup(ctx context.Context, instanceId int, groupName string, transactionIds []int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	accountService, err := slvgs.client.GetSoftLayer_Account_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	options := &softlayer.RequestOptions{
+		Filter: softlayer.NewObjectFilter().Set("blockDeviceTemplateGroups.name", softlayer.Equals(groupName)),
+	}
+
+	groups, err := accountService.GetBlockDeviceTemplateGroupsWithOptions(ctx, options)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	for _, group := range groups {
+		if group.TransactionId == nil {
+			continue
+		}
+
+		for _, transactionId := range transactionIds {
+			if *group.TransactionId == transactionId {
+				return group, nil
+			}
+		}
+	}
+
+	path := fmt.Sprintf("%s/%s", accountService.GetName(), "getBlockDeviceTemplateGroups.json")
+	return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, newNotFoundError(accountService, "getBlockDeviceTemplateGroups", path, fmt.Sprintf("no image template named '%s' was created by a transaction of virtual guest %d", groupName, instanceId))
+}
+
+// findUpgradeItemPrice returns the upgrade item price of the category with
+// exactly the capacity, listing the available capacities otherwise.
+func (slvgs *softLayer_Virtual_Guest_Service) findUpgradeItemPrice(instanceId int, itemPrices []datatypes.SoftLayer_Item_Price, categoryCode string, capacity int) (datatypes.SoftLayer_Item_Price, error) {
+	path := fmt.Sprintf("%s/%d/getUpgradeItemPrices.json", slvgs.GetName(), instanceId)
+
+	available := []int{}
+	for _, itemPrice := range itemPrices {
+		if itemPrice.Item == nil || !hasCategory(itemPrice, categoryCode) {
+			continue
+		}
+
+		itemCapacity, err := strconv.ParseFloat(itemPrice.Item.Capacity, 64)
+		if err != nil {
+			continue
+		}
+
+		if itemCapacity == float64(capacity) {
+			return itemPrice, nil
+		}
+
+		available = append(available, int(itemCapacity))
+	}
+
+	if len(available) == 0 {
+		return datatypes.SoftLayer_Item_Price{}, newNotFoundError(slvgs, "getUpgradeItemPrices", path, fmt.Sprintf("no %s upgrade is available", categoryCode))
+	}
+
+	sort.Ints(available)
+
+	capacities := []string{}
+	for i, availableCapacity := range available {
+		if i == 0 || availableCapacity != available[i-1] {
+			capacities = append(capacities, strconv.Itoa(availableCapacity))
+		}
+	}
+
+	return datatypes.SoftLayer_Item_Price{}, newNotFoundError(slvgs, "getUpgradeItemPrices", path, fmt.Sprintf("no %s upgrade to %d is available, only to %s", categoryCode, capacity, strings.Join(capacities, ", ")))
+}
+
+// activeTransactionIds returns the ids of the active transactions of the
+// virtual guest.
+func (slvgs *softLayer_Virtual_Guest_Service) activeTransactionIds(ctx context.Context, instanceId int) (map[int]bool, error) {
+	transactions, err := slvgs.GetActiveTransactionsWithContext(ctx, instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionIds := map[int]bool{}
+	for _, transaction := range transactions {
+		transactionIds[transaction.Id] = true
+	}
+
+	return transactionIds, nil
+}
+
+// waitForTemplateGroup polls findTemplateGroup with the active transactions of
+// the virtual guest that are not previous ones, until the group is found or
+// the start timeout of options passes.
+func (slvgs *softLayer_Virtual_Guest_Service) waitForTemplateGroup(ctx context.Context, instanceId int, groupName string, previousTransactionIds map[int]bool, options softlayer.OperationOptions) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	pollInterval := options.PollInterval
+	if pollInterval == 0 {
+		pollInterval = softlayer.DEFAULT_OPERATION_POLL_INTERVAL
+	}
+
+	startTimeout := options.StartTimeout
+	if startTimeout == 0 {
+		startTimeout = softlayer.DEFAULT_OPERATION_START_TIMEOUT
+	}
+
+	deadline := time.Now().Add(startTimeout)
+	for {
+		activeTransactions, err := slvgs.GetActiveTransactionsWithContext(ctx, instanceId)
+		if err != nil {
+			return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+		}
+
+		transactionIds := []int{}
+		for _, transaction := range activeTransactions {
+			if !previousTransactionIds[transaction.Id] {
+				transactionIds = append(transactionIds, transaction.Id)
+			}
+		}
+
+		group, err := slvgs.findTemplateGroup(ctx, instanceId, groupName, transactionIds)
+		if err == nil || !softlayer.IsNotFound(err) || !time.Now().Before(deadline) {
+			return group, err
+		}
+
+		select {
+		case <-time.After(pollInterval):
+		case <-ctx.Done():
+			return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, ctx.Err()
+		}
+	}
+}
+
+// findTemplateGroupByGlobalIdentifier returns the template group of the
+// account with the GUID.
+func (slvgs *softLayer_Virtual_Guest_Service) findTemplateGroupByGlobalIdentifier(ctx context.Context, globalIdentifier string) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error) {
+	accountService, err := slvgs.client.GetSoftLayer_Account_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	options := &softlayer.RequestOptions{
+		Filter: softlayer.NewObjectFilter().Set("blockDeviceTemplateGroups.globalIdentifier", softlayer.Equals(globalIdentifier)),
+	}
+
+	groups, err := accountService.GetBlockDeviceTemplateGroupsWithOptions(ctx, options)
+	if err != nil {
+		return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, err
+	}
+
+	for _, group := range groups {
+		if group.GlobalIdentifier == globalIdentifier {
+			return group, nil
+		}
+	}
+
+	path := fmt.Sprintf("%s/%s", accountService.GetName(), "getBlockDeviceTemplateGroups.json")
+	return datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group{}, newNotFoundError(accountService, "getBlockDeviceTemplateGroups", path, fmt.Sprintf("no image template with the global identifier '%s' was found", globalIdentifier))
+}
+
+// findOperatingSystemItemPrice returns the os item price of the virtual guest
+// package with the reference code.
+func (slvgs *softLayer_Virtual_Guest_Service) findOperatingSystemItemPrice(ctx context.Context, referenceCode string) (datatypes.SoftLayer_Item_Price, error) {
+	productPackageService, err := slvgs.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Item_Price{}, err
+	}
+
+	options := &softlayer.RequestOptions{
+		Mask: softlayer.NewObjectMask("id", "categories.categoryCode", "item.softwareDescription.referenceCode"),
+		Filter: softlayer.NewObjectFilter().
+			Set("itemPrices.categories.categoryCode", softlayer.Equals(OPERATING_SYSTEM_CATEGORY_CODE)).
+			Set("itemPrices.item.softwareDescription.referenceCode", softlayer.Equals(referenceCode)),
+	}
+
+	itemPrices, err := productPackageService.GetItemPricesWithOptions(ctx, VIRTUAL_GUEST_PACKAGE_ID, options)
+	if err != nil {
+		return datatypes.SoftLayer_Item_Price{}, err
+	}
+
+	for _, itemPrice := range itemPrices {
+		if itemPrice.Item == nil || itemPrice.Item.SoftwareDescription == nil || !hasCategory(itemPrice, OPERATING_SYSTEM_CATEGORY_CODE) {
+			continue
+		}
+
+		if itemPrice.Item.SoftwareDescription.ReferenceCode == referenceCode {
+			return itemPrice, nil
+		}
+	}
+
+	path := fmt.Sprintf("%s/%d/getItemPrices.json", productPackageService.GetName(), VIRTUAL_GUEST_PACKAGE_ID)
+	return datatypes.SoftLayer_Item_Price{}, newNotFoundError(productPackageService, "getItemPrices", path, fmt.Sprintf("no operating system with the reference code '%s' was found", referenceCode))
+}
+
+// placeUpgradeOrder orders the upgrade item prices of priceIds, by category
+// code, for the virtual guest.
+func (slvgs *softLayer_Virtual_Guest_Service) placeUpgradeOrder(ctx context.Context, instanceId int, priceIds map[string]int, maintenanceWindow time.Time, note string) (datatypes.SoftLayer_Product_Order_Receipt, error) {
+	service, err := slvgs.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Product_Order_Receipt{}, err
+	}
+
+	prices := []datatypes.SoftLayer_Item_Price{}
+	for _, categoryCode := range sortedCategoryCodes(priceIds) {
+		prices = append(prices, datatypes.SoftLayer_Item_Price{
+			Id: priceIds[categoryCode],
+			Categories: []datatypes.Category{
+				datatypes.Category{
+					CategoryCode: categoryCode,
+				},
+			},
+		})
+	}
+
+	order := datatypes.SoftLayer_Product_Order{
+		VirtualGuests: []datatypes.VirtualGuest{
+			datatypes.VirtualGuest{
+				Id: instanceId,
+			},
+		},
+		Prices:      prices,
+		ComplexType: "SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade",
+		Properties: []datatypes.Property{
+			datatypes.Property{
+				Name:  "MAINTENANCE_WINDOW",
+				Value: maintenanceWindow.UTC().Format(time.RFC3339),
+			},
+			datatypes.Property{
+				Name:  "NOTE_GENERAL",
+				Value: note,
+			},
+		},
+	}
+
+	return service.PlaceOrderWithContext(ctx, order)
+}
+
+//Private functions
+
+// upgradeCapacities returns the capacities of the upgrades of options by
+// category code.
+func upgradeCapacities(options softlayer.UpgradeOptions) (map[string]int, error) {
+	capacities := map[string]int{}
+
+	addCapacity := func(categoryCode string, capacity int) error {
+		if capacity < 0 {
+			return fmt.Errorf("softlayer-go: the %s upgrade can not be negative: %d", categoryCode, capacity)
+		}
+
+		if capacity > 0 {
+			capacities[categoryCode] = capacity
+		}
+
+		return nil
+	}
+
+	err := addCapacity(softlayer.UPGRADE_CPUS_CATEGORY_CODE, options.Cpus)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addCapacity(softlayer.UPGRADE_MEMORY_CATEGORY_CODE, options.MemoryGb)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addCapacity(softlayer.UPGRADE_PORT_SPEED_CATEGORY_CODE, options.PortSpeedMbps)
+	if err != nil {
+		return nil, err
+	}
+
+	for disk, capacity := range options.Disks {
+		if disk < softlayer.UPGRADE_FIRST_DISK || disk > softlayer.UPGRADE_LAST_DISK {
+			return nil, fmt.Errorf("softlayer-go: disk %d can not be upgraded, only disks %d to %d can", disk, softlayer.UPGRADE_FIRST_DISK, softlayer.UPGRADE_LAST_DISK)
+		}
+
+		err = addCapacity(fmt.Sprintf("guest_disk%d", disk), capacity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(capacities) == 0 {
+		return nil, errors.New("softlayer-go: no upgrade was requested")
+	}
+
+	return capacities, nil
+}
+
+func hasCategory(itemPrice datatypes.SoftLayer_Item_Price, categoryCode string) bool {
+	for _, category := range itemPrice.Categories {
+		if category.CategoryCode == categoryCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedCategoryCodes(values map[string]int) []string {
+	categoryCodes := []string{}
+	for categoryCode := range values {
+		categoryCodes = append(categoryCodes, categoryCode)
+	}
+	sort.Strings(categoryCodes)
+
+	return categoryCodes
+}
@@ -1,8 +1,12 @@
 package services_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -129,6 +133,53 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 		})
 	})
 
+	Context("#GetObjectWithContext", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			fakeClient.DoRawHttpRequestResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("sucessfully retrieves SoftLayer_Virtual_Guest instance", func() {
+			vg, err := virtualGuestService.GetObjectWithContext(context.Background(), virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vg.Id).To(Equal(virtualGuest.Id))
+		})
+
+		It("fails when the context is already cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := virtualGuestService.GetObjectWithContext(ctx, virtualGuest.Id)
+			Expect(err).To(Equal(context.Canceled))
+		})
+	})
+
+	Context("#GetObjectWithOptions", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			fakeClient.DoRawHttpRequestResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("sends the object mask of the options", func() {
+			options := &softlayer.RequestOptions{
+				Mask: softlayer.NewObjectMask("id").Nest("networkComponents", softlayer.NewObjectMask("primaryIpAddress")),
+			}
+
+			vg, err := virtualGuestService.GetObjectWithOptions(context.Background(), virtualGuest.Id, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vg.Id).To(Equal(virtualGuest.Id))
+			Expect(fakeClient.DoHttpRequestOptions.Mask.String()).To(Equal("mask[id,networkComponents[primaryIpAddress]]"))
+		})
+
+		It("sends the default object mask without options", func() {
+			_, err := virtualGuestService.GetObjectWithOptions(context.Background(), virtualGuest.Id, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.DoHttpRequestOptions.Mask.String()).To(ContainSubstring("operatingSystem[passwords[password,username]]"))
+		})
+	})
+
 	Context("#EditObject", func() {
 		BeforeEach(func() {
 			virtualGuest.Id = 1234567
@@ -179,8 +230,26 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 		})
 
 		It("can attach a local disk without error", func() {
-			err := virtualGuestService.AttachEphemeralDisk(123, 25)
+			itemPricesResponse, err := common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			placeOrderResponse, err := common.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClient.DoRawHttpRequestResponse = nil
+			fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/123/getUpgradeItemPrices.json", itemPricesResponse)
+			fakeClient.AddRoute("POST", "SoftLayer_Product_Order/placeOrder.json", placeOrderResponse)
+
+			err = virtualGuestService.AttachEphemeralDisk(123, 25)
 			Expect(err).ToNot(HaveOccurred())
+
+			placeOrderRequests := fakeClient.RequestsMatching("POST", "SoftLayer_Product_Order/placeOrder.json")
+			Expect(placeOrderRequests).To(HaveLen(1))
+
+			order, err := json.Marshal(placeOrderRequests[0].Parameters()[0])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(order)).To(ContainSubstring(`"id":12345`))
+			Expect(string(order)).To(ContainSubstring(`"virtualGuests":[{"id":123}]`))
 		})
 
 		It("reports error when providing a disk size that exceeds the biggest capacity disk SL can provide", func() {
@@ -302,6 +371,20 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(rebooted).To(BeFalse())
 		})
+
+		It("returns a SoftLayerError naming the failed call", func() {
+			fakeClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := virtualGuestService.PowerCycle(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+
+			slErr, ok := softlayer.AsSoftLayerError(err)
+			Expect(ok).To(BeTrue())
+			Expect(slErr.Service).To(Equal("SoftLayer_Virtual_Guest"))
+			Expect(slErr.Method).To(Equal("powerCycle"))
+			Expect(slErr.Path).To(Equal("SoftLayer_Virtual_Guest/1234567/powerCycle.json"))
+			Expect(slErr.Body).To(Equal([]byte("false")))
+		})
 	})
 
 	Context("#PowerOff", func() {
@@ -803,4 +886,548 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			Expect(available).To(BeTrue())
 		})
 	})
+
+	Context("#WaitUntilReady", func() {
+		var (
+			activeTransactionsResponse []byte
+			powerStateResponse         []byte
+
+			activeTransactionsRoute *slclientfakes.FakeRoute
+			powerStateRoute         *slclientfakes.FakeRoute
+			primaryIpAddressRoute   *slclientfakes.FakeRoute
+
+			options softlayer.ReadyOptions
+			states  []softlayer.ReadyState
+		)
+
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+
+			activeTransactionsResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getActiveTransactions.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			powerStateResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getPowerState.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			activeTransactionsRoute = fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/1234567/getActiveTransactions.json", activeTransactionsResponse, []byte("[]"))
+			powerStateRoute = fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/1234567/getPowerState.json", powerStateResponse)
+			primaryIpAddressRoute = fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/1234567/getPrimaryIpAddress.json", []byte("10.0.0.1"))
+
+			states = []softlayer.ReadyState{}
+			options = softlayer.ReadyOptions{
+				Timeout:      time.Second,
+				PollInterval: time.Millisecond,
+				Progress: func(state softlayer.ReadyState) {
+					states = append(states, state)
+				},
+			}
+		})
+
+		It("waits for the active transactions to complete", func() {
+			err := virtualGuestService.WaitUntilReady(virtualGuest.Id, options)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(states).To(HaveLen(2))
+			Expect(states[0].Ready).To(BeFalse())
+			Expect(states[0].ActiveTransactions).To(HaveLen(2))
+			Expect(states[0].String()).To(Equal("2 active transactions (CLOUD_RECLAIM_PREP)"))
+
+			Expect(states[1].Ready).To(BeTrue())
+			Expect(states[1].PowerState).To(Equal("RUNNING"))
+			Expect(states[1].PrimaryIpAddress).To(Equal("10.0.0.1"))
+
+			Expect(powerStateRoute.Calls).To(Equal(1))
+		})
+
+		It("waits for the primary IP address to be assigned", func() {
+			primaryIpAddressRoute.Responses = [][]byte{[]byte(""), []byte("10.0.0.1")}
+
+			err := virtualGuestService.WaitUntilReady(virtualGuest.Id, options)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(states).To(HaveLen(3))
+			Expect(states[1].String()).To(Equal("no primary IP address"))
+			Expect(states[2].Ready).To(BeTrue())
+		})
+
+		It("waits for the virtual guest to be pingable when asked", func() {
+			pingableRoute := fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/1234567/isPingable.json", []byte("false"), []byte("true"))
+			options.Pingable = true
+
+			err := virtualGuestService.WaitUntilReady(virtualGuest.Id, options)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pingableRoute.Calls).To(Equal(2))
+			Expect(states[len(states)-2].String()).To(Equal("not pingable"))
+			Expect(states[len(states)-1].Pingable).To(BeTrue())
+		})
+
+		It("does not check that the virtual guest is pingable by default", func() {
+			err := virtualGuestService.WaitUntilReady(virtualGuest.Id, options)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.RequestsMatching("GET", "SoftLayer_Virtual_Guest/1234567/isPingable.json")).To(BeEmpty())
+		})
+
+		It("fails with the state the virtual guest is stuck in on timeout", func() {
+			powerStateRoute.Responses = [][]byte{[]byte(`{"keyName":"HALTED","name":"Halted"}`)}
+			options.Timeout = 50 * time.Millisecond
+
+			err := virtualGuestService.WaitUntilReady(virtualGuest.Id, options)
+			Expect(err).To(MatchError("softlayer-go: virtual guest 1234567 not ready after 50ms: power state HALTED"))
+
+			notReadyErr, ok := err.(*softlayer.NotReadyError)
+			Expect(ok).To(BeTrue())
+			Expect(notReadyErr.State.PowerState).To(Equal("HALTED"))
+			Expect(notReadyErr.State.Elapsed).To(BeNumerically(">", 0))
+		})
+
+		It("fails on the errors of the API", func() {
+			activeTransactionsRoute.Error = errors.New("fake-error")
+
+			err := virtualGuestService.WaitUntilReady(virtualGuest.Id, options)
+			Expect(err).To(MatchError("fake-error"))
+			Expect(states).To(BeEmpty())
+		})
+
+		It("stops when the context is cancelled", func() {
+			powerStateRoute.Responses = [][]byte{[]byte(`{"keyName":"HALTED","name":"Halted"}`)}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			options.Progress = func(state softlayer.ReadyState) {
+				cancel()
+			}
+
+			err := virtualGuestService.WaitUntilReadyWithContext(ctx, virtualGuest.Id, options)
+			Expect(err).To(Equal(context.Canceled))
+		})
+	})
+
+	Context("#TrackTransaction", func() {
+		var transaction datatypes.SoftLayer_Provisioning_Version1_Transaction
+
+		BeforeEach(func() {
+			transactionResponse, err := common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_attachDiskImage.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = json.Unmarshal(transactionResponse, &transaction)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("follows the transaction in the active transactions of the virtual guest", func() {
+			inProgress := fmt.Sprintf(`[{"id":%d,"guestId":%d,"elapsedSeconds":6,"transactionStatus":{"name":"CLOUD_ATTACH_DISK","averageDuration":".5"}}]`, transaction.Id, transaction.GuestId)
+			otherTransaction := `[{"id":1,"transactionStatus":{"name":"CLOUD_REBOOT"}}]`
+			activeTransactionsRoute := fakeClient.AddRoute("GET", fmt.Sprintf("SoftLayer_Virtual_Guest/%d/getActiveTransactions.json", transaction.GuestId), []byte(inProgress), []byte(otherTransaction))
+
+			operation := virtualGuestService.TrackTransaction(transaction, softlayer.OperationOptions{PollInterval: time.Millisecond})
+
+			statuses := []softlayer.OperationStatus{}
+			for status := range operation.Statuses() {
+				statuses = append(statuses, status)
+			}
+			Expect(operation.Err()).ToNot(HaveOccurred())
+
+			Expect(statuses).To(Equal([]softlayer.OperationStatus{
+				{Name: "CLOUD_ATTACH_DISK", ElapsedSeconds: 6, EstimatedRemaining: 24 * time.Second},
+			}))
+			Expect(activeTransactionsRoute.Calls).To(Equal(2))
+		})
+
+		It("fails on the transactions that are not the ones of a virtual guest", func() {
+			transaction.GuestId = 0
+
+			operation := virtualGuestService.TrackTransaction(transaction, softlayer.OperationOptions{PollInterval: time.Millisecond})
+			err := operation.Wait()
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("transaction %d is not a transaction of a virtual guest", transaction.Id)))
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("#Upgrade", func() {
+		var (
+			itemPricesRoute *slclientfakes.FakeRoute
+			placeOrderRoute *slclientfakes.FakeRoute
+		)
+
+		BeforeEach(func() {
+			itemPrices := []datatypes.SoftLayer_Item_Price{}
+			addItemPrice := func(categoryCode string, capacity string) {
+				id := 100 + len(itemPrices)
+				itemPrices = append(itemPrices, datatypes.SoftLayer_Item_Price{
+					Id:         id,
+					Categories: []datatypes.Category{{CategoryCode: categoryCode}},
+					Item:       &datatypes.Item{Id: id + 100, Capacity: capacity},
+				})
+			}
+			addItemPrice("guest_core", "2")
+			addItemPrice("guest_core", "4")
+			addItemPrice("ram", "4")
+			addItemPrice("ram", "8")
+			addItemPrice("port_speed", "1000")
+			addItemPrice("guest_disk2", "100")
+			addItemPrice("guest_disk3", "100")
+
+			itemPricesResponse, err := json.Marshal(itemPrices)
+			Expect(err).ToNot(HaveOccurred())
+
+			placeOrderResponse, err := common.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			itemPricesRoute = fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/123/getUpgradeItemPrices.json", itemPricesResponse)
+			placeOrderRoute = fakeClient.AddRoute("POST", "SoftLayer_Product_Order/placeOrder.json", placeOrderResponse)
+		})
+
+		It("orders all the upgrades at once in the maintenance window", func() {
+			maintenanceWindow := time.Date(2016, time.March, 1, 10, 0, 0, 0, time.FixedZone("CET", 3600))
+
+			receipt, err := virtualGuestService.Upgrade(123, softlayer.UpgradeOptions{
+				Cpus:              4,
+				MemoryGb:          8,
+				PortSpeedMbps:     1000,
+				Disks:             map[int]int{3: 100},
+				MaintenanceWindow: maintenanceWindow,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).ToNot(Equal(0))
+
+			placeOrderRequests := fakeClient.RequestsMatching("POST", "SoftLayer_Product_Order/placeOrder.json")
+			Expect(placeOrderRequests).To(HaveLen(1))
+
+			order, err := json.Marshal(placeOrderRequests[0].Parameters()[0])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(order)).To(ContainSubstring(`"complexType":"SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade"`))
+			Expect(string(order)).To(ContainSubstring(`"prices":[{"categories":[{"categoryCode":"guest_core"}],"id":101},{"categories":[{"categoryCode":"guest_disk3"}],"id":106},{"categories":[{"categoryCode":"port_speed"}],"id":104},{"categories":[{"categoryCode":"ram"}],"id":103}]`))
+			Expect(string(order)).To(ContainSubstring(`"virtualGuests":[{"id":123}]`))
+			Expect(string(order)).To(ContainSubstring(`{"name":"MAINTENANCE_WINDOW","value":"2016-03-01T09:00:00Z"}`))
+		})
+
+		It("reports the available capacities when the requested one is not", func() {
+			_, err := virtualGuestService.Upgrade(123, softlayer.UpgradeOptions{Cpus: 4, MemoryGb: 6})
+			Expect(err).To(MatchError("softlayer-go: SoftLayer_Virtual_Guest#getUpgradeItemPrices: SoftLayer_Exception_NotFound: no ram upgrade to 6 is available, only to 4, 8"))
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+			Expect(placeOrderRoute.Calls).To(Equal(0))
+		})
+
+		It("reports the categories without upgrades", func() {
+			_, err := virtualGuestService.Upgrade(123, softlayer.UpgradeOptions{Disks: map[int]int{4: 100}})
+			Expect(err.Error()).To(ContainSubstring("no guest_disk4 upgrade is available"))
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+			Expect(placeOrderRoute.Calls).To(Equal(0))
+		})
+
+		It("validates the options before getting the upgrade item prices", func() {
+			_, err := virtualGuestService.Upgrade(123, softlayer.UpgradeOptions{})
+			Expect(err).To(MatchError("softlayer-go: no upgrade was requested"))
+
+			_, err = virtualGuestService.Upgrade(123, softlayer.UpgradeOptions{MemoryGb: -1})
+			Expect(err).To(MatchError("softlayer-go: the ram upgrade can not be negative: -1"))
+
+			_, err = virtualGuestService.Upgrade(123, softlayer.UpgradeOptions{Disks: map[int]int{1: 100}})
+			Expect(err).To(MatchError("softlayer-go: disk 1 can not be upgraded, only disks 2 to 5 can"))
+
+			Expect(itemPricesRoute.Calls).To(Equal(0))
+		})
+	})
+
+	Context("#GetBlockDevices", func() {
+		BeforeEach(func() {
+			fakeClient.DoRawHttpRequestResponse, err = common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getBlockDevices.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the block devices with their disk image", func() {
+			blockDevices, err := virtualGuestService.GetBlockDevices(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(blockDevices).To(HaveLen(2))
+			Expect(blockDevices[1].Device).To(Equal("1"))
+			Expect(blockDevices[1].DiskImage.Description).To(Equal("fake-hostname.fake.domain.com-SWAP"))
+
+			Expect(fakeClient.Requests[0].Options.Mask.String()).To(ContainSubstring("diskImage"))
+		})
+
+		It("sends the object mask of the options instead of the default one", func() {
+			options := &softlayer.RequestOptions{Mask: softlayer.NewObjectMask("id", "device", "diskImage.capacity")}
+
+			_, err := virtualGuestService.GetBlockDevicesWithOptions(context.Background(), 1234567, options)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.Requests[0].Options.Mask.String()).To(Equal("mask[id,device,diskImage[capacity]]"))
+		})
+	})
+
+	Context("image templates", func() {
+		var templateGroupsRoute *slclientfakes.FakeRoute
+
+		BeforeEach(func() {
+			transactionId := 11878010
+			templateGroupsRoute = fakeClient.AddRoute("GET", "SoftLayer_Account/getBlockDeviceTemplateGroups.json",
+				[]byte(fmt.Sprintf(`[{"id":2,"name":"fake-image"},{"id":1,"name":"fake-image","transactionId":%d}]`, transactionId)))
+		})
+
+		Context("#CreateArchiveTransaction", func() {
+			var blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device
+
+			BeforeEach(func() {
+				transactionResponse, err := common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_createArchiveTransaction.json")
+				Expect(err).ToNot(HaveOccurred())
+				fakeClient.AddRoute("POST", "SoftLayer_Virtual_Guest/1234567/createArchiveTransaction.json", transactionResponse)
+
+				blockDevices = []datatypes.SoftLayer_Virtual_Guest_Block_Device{{Id: 3456789, Device: "0"}}
+			})
+
+			It("captures the block devices and returns the template group of the transaction", func() {
+				group, err := virtualGuestService.CreateArchiveTransaction(1234567, "fake-image", blockDevices, "fake-note")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(group.Id).To(Equal(1))
+
+				createRequests := fakeClient.RequestsMatching("POST", "SoftLayer_Virtual_Guest/1234567/createArchiveTransaction.json")
+				Expect(createRequests).To(HaveLen(1))
+
+				parameters, err := json.Marshal(createRequests[0].Parameters())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(parameters)).To(Equal(`["fake-image",[{"id":3456789}],"fake-note"]`))
+
+				Expect(templateGroupsRoute.Calls).To(Equal(1))
+				Expect(fakeClient.Requests[1].Options.Filter.String()).To(ContainSubstring(`"name":{"operation":"fake-image"}`))
+			})
+
+			It("fails without a name or block devices", func() {
+				_, err := virtualGuestService.CreateArchiveTransaction(1234567, "", blockDevices, "fake-note")
+				Expect(err).To(MatchError("softlayer-go: the name of the image template is required"))
+
+				_, err = virtualGuestService.CreateArchiveTransaction(1234567, "fake-image", nil, "fake-note")
+				Expect(err).To(MatchError("softlayer-go: at least one block device is required to create an image template"))
+
+				Expect(fakeClient.Requests).To(BeEmpty())
+			})
+
+			It("fails when no template group was created by the transaction", func() {
+				templateGroupsRoute.Responses = [][]byte{[]byte(`[{"id":2,"name":"fake-image"},{"id":3,"name":"fake-image","transactionId":1}]`)}
+
+				_, err := virtualGuestService.CreateArchiveTransaction(1234567, "fake-image", blockDevices, "fake-note")
+				Expect(err.Error()).To(ContainSubstring("no image template named 'fake-image' was created by a transaction of virtual guest 1234567"))
+				Expect(softlayer.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		Context("#CaptureImage", func() {
+			var (
+				template                datatypes.SoftLayer_Container_Disk_Image_Capture_Template
+				options                 softlayer.OperationOptions
+				activeTransactionsRoute *slclientfakes.FakeRoute
+			)
+
+			BeforeEach(func() {
+				captureResponse, err := common.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_captureImage.json")
+				Expect(err).ToNot(HaveOccurred())
+				fakeClient.AddRoute("POST", "SoftLayer_Virtual_Guest/1234567/captureImage.json", captureResponse)
+
+				previous := `[{"id":1,"guestId":1234567}]`
+				capturing := `[{"id":1,"guestId":1234567},{"id":11878010,"guestId":1234567}]`
+				activeTransactionsRoute = fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/1234567/getActiveTransactions.json", []byte(previous), []byte(previous), []byte(capturing))
+
+				templateGroupsRoute.Responses = [][]byte{[]byte(`[{"id":3,"name":"fake-flex-image","transactionId":1},{"id":2,"name":"fake-flex-image"},{"id":1,"name":"fake-flex-image","transactionId":11878010}]`)}
+
+				template = datatypes.SoftLayer_Container_Disk_Image_Capture_Template{
+					Name: "fake-image",
+					Volumes: []datatypes.SoftLayer_Container_Disk_Image_Capture_Template_Volume{
+						{Name: "Disk 0", Partitions: []datatypes.SoftLayer_Container_Disk_Image_Capture_Template_Volume_Partition{{Name: "/", IsRoot: true}}},
+					},
+				}
+				options = softlayer.OperationOptions{PollInterval: time.Millisecond}
+			})
+
+			It("captures a flex image and returns the template group of the transaction started by the capture", func() {
+				group, err := virtualGuestService.CaptureImage(1234567, template, options)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(group.Id).To(Equal(1))
+				Expect(activeTransactionsRoute.Calls).To(Equal(3))
+
+				captureRequests := fakeClient.RequestsMatching("POST", "SoftLayer_Virtual_Guest/1234567/captureImage.json")
+				Expect(captureRequests).To(HaveLen(1))
+
+				parameters, err := json.Marshal(captureRequests[0].Parameters())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(parameters)).To(Equal(`[{"name":"fake-image","volumes":[{"name":"Disk 0","partitions":[{"isRoot":true,"name":"/"}]}]}]`))
+			})
+
+			It("looks up the template group by the name of the captured template", func() {
+				_, err := virtualGuestService.CaptureImage(1234567, template, options)
+				Expect(err).ToNot(HaveOccurred())
+
+				groupsRequests := fakeClient.RequestsMatching("GET", "SoftLayer_Account/getBlockDeviceTemplateGroups.json")
+				Expect(groupsRequests).ToNot(BeEmpty())
+				Expect(groupsRequests[0].Options.Filter.String()).To(ContainSubstring(`"name":{"operation":"fake-flex-image"}`))
+			})
+
+			It("fails when no template group was created by a transaction started by the capture", func() {
+				activeTransactionsRoute.Responses = activeTransactionsRoute.Responses[:1]
+				options.StartTimeout = 20 * time.Millisecond
+
+				_, err := virtualGuestService.CaptureImage(1234567, template, options)
+				Expect(err.Error()).To(ContainSubstring("no image template named 'fake-flex-image' was created by a transaction of virtual guest 1234567"))
+				Expect(softlayer.IsNotFound(err)).To(BeTrue())
+				Expect(activeTransactionsRoute.Calls).To(BeNumerically(">", 2))
+			})
+
+			It("fails without a name", func() {
+				_, err := virtualGuestService.CaptureImage(1234567, datatypes.SoftLayer_Container_Disk_Image_Capture_Template{}, options)
+				Expect(err).To(MatchError("softlayer-go: the name of the image template is required"))
+				Expect(fakeClient.Requests).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("#ReloadOperatingSystem", func() {
+		var (
+			config                  softlayer.ReloadConfig
+			options                 softlayer.OperationOptions
+			templateGroupsRoute     *slclientfakes.FakeRoute
+			itemPricesRoute         *slclientfakes.FakeRoute
+			reloadRoute             *slclientfakes.FakeRoute
+			activeTransactionsRoute *slclientfakes.FakeRoute
+		)
+
+		reload := func() string {
+			operation, err := virtualGuestService.ReloadOperatingSystem(1234567, config, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operation.Wait()).ToNot(HaveOccurred())
+
+			reloadRequests := fakeClient.RequestsMatching("POST", "SoftLayer_Virtual_Guest/1234567/reloadOperatingSystem.json")
+			Expect(reloadRequests).To(HaveLen(1))
+
+			parameters, err := json.Marshal(reloadRequests[0].Parameters())
+			Expect(err).ToNot(HaveOccurred())
+
+			return string(parameters)
+		}
+
+		BeforeEach(func() {
+			config = softlayer.ReloadConfig{}
+			options = softlayer.OperationOptions{PollInterval: time.Millisecond}
+
+			previous := `[{"id":1,"guestId":1234567,"transactionStatus":{"name":"CLOUD_CONFIGURE_NETWORK"}}]`
+			reloading := `[{"id":1,"guestId":1234567,"transactionStatus":{"name":"CLOUD_CONFIGURE_NETWORK"}},{"id":2,"guestId":1234567,"transactionStatus":{"name":"CLOUD_INSTALL_OS"}}]`
+
+			templateGroupsRoute = fakeClient.AddRoute("GET", "SoftLayer_Account/getBlockDeviceTemplateGroups.json", []byte(`[{"id":345,"globalIdentifier":"fake-image-guid"}]`))
+			itemPricesRoute = fakeClient.AddRoute("GET", "SoftLayer_Product_Package/46/getItemPrices.json", []byte(`[{"id":1234,"categories":[{"categoryCode":"os"}],"item":{"softwareDescription":{"referenceCode":"UBUNTU_LATEST"}}}]`))
+			reloadRoute = fakeClient.AddRoute("POST", "SoftLayer_Virtual_Guest/1234567/reloadOperatingSystem.json", []byte(`"1"`))
+			activeTransactionsRoute = fakeClient.AddRoute("GET", "SoftLayer_Virtual_Guest/1234567/getActiveTransactions.json", []byte(previous), []byte(previous), []byte(reloading), []byte(`[]`))
+		})
+
+		It("forces the reload of the current operating system without configuration", func() {
+			Expect(reload()).To(Equal(`["FORCE",{}]`))
+			Expect(templateGroupsRoute.Calls).To(Equal(0))
+			Expect(itemPricesRoute.Calls).To(Equal(0))
+		})
+
+		It("sends the image template as the id of its template group", func() {
+			config.ImageTemplateGlobalIdentifier = "fake-image-guid"
+			Expect(reload()).To(Equal(`["FORCE",{"imageTemplateId":345}]`))
+
+			groupsRequests := fakeClient.RequestsMatching("GET", "SoftLayer_Account/getBlockDeviceTemplateGroups.json")
+			Expect(groupsRequests).To(HaveLen(1))
+			Expect(groupsRequests[0].Options.Filter.String()).To(ContainSubstring(`"globalIdentifier":{"operation":"fake-image-guid"}`))
+		})
+
+		It("sends the operating system reference code as its os item price", func() {
+			config.OperatingSystemReferenceCode = "UBUNTU_LATEST"
+			Expect(reload()).To(Equal(`["FORCE",{"itemPrices":[{"id":1234}]}]`))
+
+			itemPricesRequests := fakeClient.RequestsMatching("GET", "SoftLayer_Product_Package/46/getItemPrices.json")
+			Expect(itemPricesRequests).To(HaveLen(1))
+			Expect(itemPricesRequests[0].Options.Filter.String()).To(ContainSubstring(`"referenceCode":{"operation":"UBUNTU_LATEST"}`))
+			Expect(itemPricesRequests[0].Options.Filter.String()).To(ContainSubstring(`"categoryCode":{"operation":"os"}`))
+		})
+
+		It("sends the ssh keys", func() {
+			config.SshKeyIds = []int{1234, 5678}
+			Expect(reload()).To(Equal(`["FORCE",{"sshKeyIds":[1234,5678]}]`))
+		})
+
+		It("sends the post install script", func() {
+			config.PostInstallScriptUri = "https://fake.domain.com/post-install.sh"
+			Expect(reload()).To(Equal(`["FORCE",{"postInstallScriptUri":"https://fake.domain.com/post-install.sh"}]`))
+		})
+
+		It("sends the custom provision script", func() {
+			config.CustomProvisionScriptUri = "https://fake.domain.com/provision.sh"
+			Expect(reload()).To(Equal(`["FORCE",{"customProvisionScriptUri":"https://fake.domain.com/provision.sh"}]`))
+		})
+
+		It("follows the transaction created by the reload", func() {
+			operation, err := virtualGuestService.ReloadOperatingSystem(1234567, config, options)
+			Expect(err).ToNot(HaveOccurred())
+
+			statuses := []softlayer.OperationStatus{}
+			for status := range operation.Statuses() {
+				statuses = append(statuses, status)
+			}
+			Expect(operation.Err()).ToNot(HaveOccurred())
+
+			Expect(statuses).To(Equal([]softlayer.OperationStatus{{Name: "CLOUD_INSTALL_OS"}}))
+			Expect(operation.Transaction().Id).To(Equal(2))
+			Expect(operation.Transaction().GuestId).To(Equal(1234567))
+			Expect(activeTransactionsRoute.Calls).To(Equal(4))
+		})
+
+		It("does not fail once the reload is accepted", func() {
+			activeTransactionsRoute.Responses = activeTransactionsRoute.Responses[:1]
+
+			operation, err := virtualGuestService.ReloadOperatingSystem(1234567, config, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reloadRoute.Calls).To(Equal(1))
+
+			Consistently(operation.Statuses()).ShouldNot(Receive())
+			operation.Cancel()
+			Expect(operation.Wait()).To(Equal(context.Canceled))
+		})
+
+		It("fails the operation when the reload transaction never appears", func() {
+			activeTransactionsRoute.Responses = activeTransactionsRoute.Responses[:1]
+			options.StartTimeout = 20 * time.Millisecond
+
+			operation, err := virtualGuestService.ReloadOperatingSystem(1234567, config, options)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = operation.Wait()
+			Expect(err).To(MatchError("softlayer-go: no transaction started within 20ms"))
+			Expect(activeTransactionsRoute.Calls).To(BeNumerically(">", 2))
+		})
+
+		It("fails when the reload is not accepted", func() {
+			reloadRoute.Responses = [][]byte{[]byte(`"0"`)}
+
+			_, err := virtualGuestService.ReloadOperatingSystem(1234567, config, options)
+			Expect(err.Error()).To(ContainSubstring("Failed to reload the operating system of instance with id '1234567', got '0' as response from the API."))
+		})
+
+		It("fails without reloading with both an image template and an operating system reference code", func() {
+			config.ImageTemplateGlobalIdentifier = "fake-image-guid"
+			config.OperatingSystemReferenceCode = "UBUNTU_LATEST"
+
+			_, err := virtualGuestService.ReloadOperatingSystem(1234567, config, options)
+			Expect(err).To(MatchError("softlayer-go: an operating system reload takes either an image template or an operating system reference code, not both"))
+			Expect(fakeClient.Requests).To(BeEmpty())
+		})
+
+		It("fails without reloading when the image template does not exist", func() {
+			config.ImageTemplateGlobalIdentifier = "fake-image-guid"
+			templateGroupsRoute.Responses = [][]byte{[]byte(`[]`)}
+
+			_, err := virtualGuestService.ReloadOperatingSystem(1234567, config, options)
+			Expect(err.Error()).To(ContainSubstring("no image template with the global identifier 'fake-image-guid' was found"))
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+			Expect(reloadRoute.Calls).To(Equal(0))
+		})
+
+		It("fails without reloading when the operating system does not exist", func() {
+			config.OperatingSystemReferenceCode = "FAKE_OS"
+
+			_, err := virtualGuestService.ReloadOperatingSystem(1234567, config, options)
+			Expect(err.Error()).To(ContainSubstring("no operating system with the reference code 'FAKE_OS' was found"))
+			Expect(softlayer.IsNotFound(err)).To(BeTrue())
+			Expect(reloadRoute.Calls).To(Equal(0))
+		})
+	})
 })
@@ -2,6 +2,7 @@ package softlayer
 
 import (
 	"bytes"
+	"context"
 )
 
 type Client interface {
@@ -20,6 +21,10 @@ type Client interface {
 
 	DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, error)
 	DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error)
+	DoRawHttpRequestWithContext(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, error)
+	DoRawHttpRequestWithObjectMaskWithContext(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error)
+	DoHttpRequestWithContext(ctx context.Context, path string, options *RequestOptions, requestType string, requestBody *bytes.Buffer, result interface{}) error
+	Invoke(ctx context.Context, invocation Invocation, result interface{}) error
 	GenerateRequestBody(templateData interface{}) (*bytes.Buffer, error)
 	HasErrors(body map[string]interface{}) error
 
@@ -0,0 +1,124 @@
+package softlayer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND     = "SoftLayer_Exception_ObjectNotFound"
+	SOFTLAYER_EXCEPTION_NOT_FOUND            = "SoftLayer_Exception_NotFound"
+	SOFTLAYER_EXCEPTION_INVALID_CREDENTIALS  = "SoftLayer_Exception_InvalidCredentials"
+	SOFTLAYER_EXCEPTION_INVALID_LEGACY_TOKEN = "SoftLayer_Exception_InvalidLegacyToken"
+	SOFTLAYER_EXCEPTION_ACCESS_DENIED        = "SoftLayer_Exception_AccessDenied"
+	SOFTLAYER_EXCEPTION_RATE_LIMIT_EXCEEDED  = "SoftLayer_Exception_WebService_RateLimitExceeded"
+)
+
+// SoftLayerError describes a failed call to the SoftLayer API. It is returned
+// by the client when the API answers with an HTTP error status or an error
+// body, and by the services when a response cannot be understood.
+type SoftLayerError struct {
+	// StatusCode is the HTTP status of the response, 0 when the error was
+	// detected without one (e.g. an unexpected body on a 200 response).
+	StatusCode int
+
+	// Code is the SoftLayer exception class, e.g. SoftLayer_Exception_ObjectNotFound.
+	Code    string
+	Message string
+
+	Service string
+	Method  string
+	Path    string
+
+	// Body is the raw response body as returned by the API.
+	Body []byte
+
+	// Attempts is the number of requests made before giving up.
+	Attempts int
+
+	// Err is the underlying transport error, if the API could not be reached.
+	Err error
+}
+
+func (e *SoftLayerError) Error() string {
+	call := e.Path
+	if e.Service != "" && e.Method != "" {
+		call = fmt.Sprintf("%s#%s", e.Service, e.Method)
+	}
+
+	msg := "softlayer-go:"
+	if call != "" {
+		msg = fmt.Sprintf("%s %s", msg, call)
+	}
+
+	if e.StatusCode != 0 {
+		msg = fmt.Sprintf("%s (HTTP %d)", msg, e.StatusCode)
+	}
+
+	if e.Code != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Code)
+	}
+
+	msg = fmt.Sprintf("%s: %s", msg, e.Message)
+
+	if e.Attempts > 1 {
+		msg = fmt.Sprintf("%s (after %d attempts)", msg, e.Attempts)
+	}
+
+	return msg
+}
+
+func (e *SoftLayerError) Unwrap() error {
+	return e.Err
+}
+
+// IsNotFound reports whether err is a SoftLayerError for an object that does
+// not exist.
+func IsNotFound(err error) bool {
+	slErr, ok := AsSoftLayerError(err)
+	if !ok {
+		return false
+	}
+
+	return slErr.StatusCode == http.StatusNotFound ||
+		slErr.Code == SOFTLAYER_EXCEPTION_OBJECT_NOT_FOUND ||
+		slErr.Code == SOFTLAYER_EXCEPTION_NOT_FOUND
+}
+
+// IsAuthError reports whether err is a SoftLayerError caused by invalid
+// credentials or missing permissions.
+func IsAuthError(err error) bool {
+	slErr, ok := AsSoftLayerError(err)
+	if !ok {
+		return false
+	}
+
+	return slErr.StatusCode == http.StatusUnauthorized ||
+		slErr.StatusCode == http.StatusForbidden ||
+		slErr.Code == SOFTLAYER_EXCEPTION_INVALID_CREDENTIALS ||
+		slErr.Code == SOFTLAYER_EXCEPTION_INVALID_LEGACY_TOKEN ||
+		slErr.Code == SOFTLAYER_EXCEPTION_ACCESS_DENIED
+}
+
+// IsRateLimited reports whether err is a SoftLayerError returned because the
+// per-user API request limit was exceeded.
+func IsRateLimited(err error) bool {
+	slErr, ok := AsSoftLayerError(err)
+	if !ok {
+		return false
+	}
+
+	return slErr.StatusCode == http.StatusTooManyRequests ||
+		slErr.Code == SOFTLAYER_EXCEPTION_RATE_LIMIT_EXCEEDED
+}
+
+// AsSoftLayerError returns the SoftLayerError wrapped in err, if any.
+func AsSoftLayerError(err error) (*SoftLayerError, bool) {
+	var slErr *SoftLayerError
+	if errors.As(err, &slErr) {
+		return slErr, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,98 @@
+package softlayer_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("SoftLayerError", func() {
+	var slErr *softlayer.SoftLayerError
+
+	BeforeEach(func() {
+		slErr = &softlayer.SoftLayerError{
+			StatusCode: 404,
+			Code:       "SoftLayer_Exception_ObjectNotFound",
+			Message:    "Unable to find object with id of '1234567'.",
+			Service:    "SoftLayer_Virtual_Guest",
+			Method:     "getObject",
+			Path:       "SoftLayer_Virtual_Guest/1234567/getObject.json",
+		}
+	})
+
+	Context("#Error", func() {
+		It("includes the call, the HTTP status, the exception code and the message", func() {
+			Expect(slErr.Error()).To(Equal("softlayer-go: SoftLayer_Virtual_Guest#getObject (HTTP 404): SoftLayer_Exception_ObjectNotFound: Unable to find object with id of '1234567'."))
+		})
+
+		It("falls back to the path when the service and method are unknown", func() {
+			slErr = &softlayer.SoftLayerError{
+				Message: "fake-message",
+				Path:    "fake-path",
+			}
+			Expect(slErr.Error()).To(Equal("softlayer-go: fake-path: fake-message"))
+		})
+
+		It("includes the number of attempts when the call was retried", func() {
+			slErr.Attempts = 3
+			Expect(slErr.Error()).To(ContainSubstring("(after 3 attempts)"))
+		})
+	})
+
+	Context("#IsNotFound", func() {
+		It("is true for a SoftLayer_Exception_ObjectNotFound exception", func() {
+			slErr.StatusCode = 500
+			Expect(softlayer.IsNotFound(slErr)).To(BeTrue())
+		})
+
+		It("is true for an HTTP 404 status", func() {
+			slErr.Code = ""
+			Expect(softlayer.IsNotFound(slErr)).To(BeTrue())
+		})
+
+		It("is true for a wrapped SoftLayerError", func() {
+			Expect(softlayer.IsNotFound(fmt.Errorf("wrapped: %w", slErr))).To(BeTrue())
+		})
+
+		It("is false for other errors", func() {
+			Expect(softlayer.IsNotFound(errors.New("fake-error"))).To(BeFalse())
+			Expect(softlayer.IsNotFound(nil)).To(BeFalse())
+		})
+	})
+
+	Context("#IsAuthError", func() {
+		It("is true for invalid credentials", func() {
+			slErr = &softlayer.SoftLayerError{StatusCode: 401, Code: "SoftLayer_Exception_InvalidCredentials"}
+			Expect(softlayer.IsAuthError(slErr)).To(BeTrue())
+		})
+
+		It("is true for an HTTP 403 status", func() {
+			slErr = &softlayer.SoftLayerError{StatusCode: 403}
+			Expect(softlayer.IsAuthError(slErr)).To(BeTrue())
+		})
+
+		It("is false for a missing object", func() {
+			Expect(softlayer.IsAuthError(slErr)).To(BeFalse())
+		})
+	})
+
+	Context("#IsRateLimited", func() {
+		It("is true for an HTTP 429 status", func() {
+			slErr = &softlayer.SoftLayerError{StatusCode: 429}
+			Expect(softlayer.IsRateLimited(slErr)).To(BeTrue())
+		})
+
+		It("is true for a SoftLayer_Exception_WebService_RateLimitExceeded exception", func() {
+			slErr = &softlayer.SoftLayerError{StatusCode: 500, Code: "SoftLayer_Exception_WebService_RateLimitExceeded"}
+			Expect(softlayer.IsRateLimited(slErr)).To(BeTrue())
+		})
+
+		It("is false for a missing object", func() {
+			Expect(softlayer.IsRateLimited(slErr)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,451 @@
+// Code generated by slgo-fakes. DO NOT EDIT.
+
+package softlayer_fakes
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ softlayer.Client = new(FakeClient)
+
+type FakeClient struct {
+	mutex sync.Mutex
+
+	CheckForHttpResponseErrorsStub  func(data []byte) error
+	CheckForHttpResponseErrorsCalls []struct {
+		Data []byte
+	}
+	CheckForHttpResponseErrorsError error
+
+	DoHttpRequestWithContextStub  func(ctx context.Context, path string, options *softlayer.RequestOptions, requestType string, requestBody *bytes.Buffer, result interface{}) error
+	DoHttpRequestWithContextCalls []struct {
+		Ctx         context.Context
+		Path        string
+		Options     *softlayer.RequestOptions
+		RequestType string
+		RequestBody *bytes.Buffer
+		Result      interface{}
+	}
+	DoHttpRequestWithContextError error
+
+	DoRawHttpRequestStub  func(path string, requestType string, requestBody *bytes.Buffer) ([]byte, error)
+	DoRawHttpRequestCalls []struct {
+		Path        string
+		RequestType string
+		RequestBody *bytes.Buffer
+	}
+	DoRawHttpRequestResult []byte
+	DoRawHttpRequestError  error
+
+	DoRawHttpRequestWithContextStub  func(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, error)
+	DoRawHttpRequestWithContextCalls []struct {
+		Ctx         context.Context
+		Path        string
+		RequestType string
+		RequestBody *bytes.Buffer
+	}
+	DoRawHttpRequestWithContextResult []byte
+	DoRawHttpRequestWithContextError  error
+
+	DoRawHttpRequestWithObjectMaskStub  func(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error)
+	DoRawHttpRequestWithObjectMaskCalls []struct {
+		Path        string
+		Masks       []string
+		RequestType string
+		RequestBody *bytes.Buffer
+	}
+	DoRawHttpRequestWithObjectMaskResult []byte
+	DoRawHttpRequestWithObjectMaskError  error
+
+	DoRawHttpRequestWithObjectMaskWithContextStub  func(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error)
+	DoRawHttpRequestWithObjectMaskWithContextCalls []struct {
+		Ctx         context.Context
+		Path        string
+		Masks       []string
+		RequestType string
+		RequestBody *bytes.Buffer
+	}
+	DoRawHttpRequestWithObjectMaskWithContextResult []byte
+	DoRawHttpRequestWithObjectMaskWithContextError  error
+
+	GenerateRequestBodyStub  func(templateData interface{}) (*bytes.Buffer, error)
+	GenerateRequestBodyCalls []struct {
+		TemplateData interface{}
+	}
+	GenerateRequestBodyResult *bytes.Buffer
+	GenerateRequestBodyError  error
+
+	GetServiceStub  func(name string) (softlayer.Service, error)
+	GetServiceCalls []struct {
+		Name string
+	}
+	GetServiceResult softlayer.Service
+	GetServiceError  error
+
+	GetSoftLayer_Account_ServiceStub   func() (softlayer.SoftLayer_Account_Service, error)
+	GetSoftLayer_Account_ServiceCalls  []struct{}
+	GetSoftLayer_Account_ServiceResult softlayer.SoftLayer_Account_Service
+	GetSoftLayer_Account_ServiceError  error
+
+	GetSoftLayer_Billing_Item_Cancellation_Request_ServiceStub   func() (softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service, error)
+	GetSoftLayer_Billing_Item_Cancellation_Request_ServiceCalls  []struct{}
+	GetSoftLayer_Billing_Item_Cancellation_Request_ServiceResult softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service
+	GetSoftLayer_Billing_Item_Cancellation_Request_ServiceError  error
+
+	GetSoftLayer_Hardware_ServiceStub   func() (softlayer.SoftLayer_Hardware_Service, error)
+	GetSoftLayer_Hardware_ServiceCalls  []struct{}
+	GetSoftLayer_Hardware_ServiceResult softlayer.SoftLayer_Hardware_Service
+	GetSoftLayer_Hardware_ServiceError  error
+
+	GetSoftLayer_Network_Storage_ServiceStub   func() (softlayer.SoftLayer_Network_Storage_Service, error)
+	GetSoftLayer_Network_Storage_ServiceCalls  []struct{}
+	GetSoftLayer_Network_Storage_ServiceResult softlayer.SoftLayer_Network_Storage_Service
+	GetSoftLayer_Network_Storage_ServiceError  error
+
+	GetSoftLayer_Product_Order_ServiceStub   func() (softlayer.SoftLayer_Product_Order_Service, error)
+	GetSoftLayer_Product_Order_ServiceCalls  []struct{}
+	GetSoftLayer_Product_Order_ServiceResult softlayer.SoftLayer_Product_Order_Service
+	GetSoftLayer_Product_Order_ServiceError  error
+
+	GetSoftLayer_Product_Package_ServiceStub   func() (softlayer.SoftLayer_Product_Package_Service, error)
+	GetSoftLayer_Product_Package_ServiceCalls  []struct{}
+	GetSoftLayer_Product_Package_ServiceResult softlayer.SoftLayer_Product_Package_Service
+	GetSoftLayer_Product_Package_ServiceError  error
+
+	GetSoftLayer_Security_Ssh_Key_ServiceStub   func() (softlayer.SoftLayer_Security_Ssh_Key_Service, error)
+	GetSoftLayer_Security_Ssh_Key_ServiceCalls  []struct{}
+	GetSoftLayer_Security_Ssh_Key_ServiceResult softlayer.SoftLayer_Security_Ssh_Key_Service
+	GetSoftLayer_Security_Ssh_Key_ServiceError  error
+
+	GetSoftLayer_Virtual_Disk_Image_ServiceStub   func() (softlayer.SoftLayer_Virtual_Disk_Image_Service, error)
+	GetSoftLayer_Virtual_Disk_Image_ServiceCalls  []struct{}
+	GetSoftLayer_Virtual_Disk_Image_ServiceResult softlayer.SoftLayer_Virtual_Disk_Image_Service
+	GetSoftLayer_Virtual_Disk_Image_ServiceError  error
+
+	GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceStub   func() (softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, error)
+	GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceCalls  []struct{}
+	GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceResult softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service
+	GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceError  error
+
+	GetSoftLayer_Virtual_Guest_ServiceStub   func() (softlayer.SoftLayer_Virtual_Guest_Service, error)
+	GetSoftLayer_Virtual_Guest_ServiceCalls  []struct{}
+	GetSoftLayer_Virtual_Guest_ServiceResult softlayer.SoftLayer_Virtual_Guest_Service
+	GetSoftLayer_Virtual_Guest_ServiceError  error
+
+	HasErrorsStub  func(body map[string]interface{}) error
+	HasErrorsCalls []struct {
+		Body map[string]interface{}
+	}
+	HasErrorsError error
+
+	InvokeStub  func(ctx context.Context, invocation softlayer.Invocation, result interface{}) error
+	InvokeCalls []struct {
+		Ctx        context.Context
+		Invocation softlayer.Invocation
+		Result     interface{}
+	}
+	InvokeError error
+}
+
+func (fake *FakeClient) CheckForHttpResponseErrors(data []byte) error {
+	fake.mutex.Lock()
+	fake.CheckForHttpResponseErrorsCalls = append(fake.CheckForHttpResponseErrorsCalls, struct {
+		Data []byte
+	}{data})
+	stub := fake.CheckForHttpResponseErrorsStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(data)
+	}
+
+	return fake.CheckForHttpResponseErrorsError
+}
+
+func (fake *FakeClient) DoHttpRequestWithContext(ctx context.Context, path string, options *softlayer.RequestOptions, requestType string, requestBody *bytes.Buffer, result interface{}) error {
+	fake.mutex.Lock()
+	fake.DoHttpRequestWithContextCalls = append(fake.DoHttpRequestWithContextCalls, struct {
+		Ctx         context.Context
+		Path        string
+		Options     *softlayer.RequestOptions
+		RequestType string
+		RequestBody *bytes.Buffer
+		Result      interface{}
+	}{ctx, path, options, requestType, requestBody, result})
+	stub := fake.DoHttpRequestWithContextStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, path, options, requestType, requestBody, result)
+	}
+
+	return fake.DoHttpRequestWithContextError
+}
+
+func (fake *FakeClient) DoRawHttpRequest(path string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	fake.mutex.Lock()
+	fake.DoRawHttpRequestCalls = append(fake.DoRawHttpRequestCalls, struct {
+		Path        string
+		RequestType string
+		RequestBody *bytes.Buffer
+	}{path, requestType, requestBody})
+	stub := fake.DoRawHttpRequestStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(path, requestType, requestBody)
+	}
+
+	return fake.DoRawHttpRequestResult, fake.DoRawHttpRequestError
+}
+
+func (fake *FakeClient) DoRawHttpRequestWithContext(ctx context.Context, path string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	fake.mutex.Lock()
+	fake.DoRawHttpRequestWithContextCalls = append(fake.DoRawHttpRequestWithContextCalls, struct {
+		Ctx         context.Context
+		Path        string
+		RequestType string
+		RequestBody *bytes.Buffer
+	}{ctx, path, requestType, requestBody})
+	stub := fake.DoRawHttpRequestWithContextStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, path, requestType, requestBody)
+	}
+
+	return fake.DoRawHttpRequestWithContextResult, fake.DoRawHttpRequestWithContextError
+}
+
+func (fake *FakeClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	fake.mutex.Lock()
+	fake.DoRawHttpRequestWithObjectMaskCalls = append(fake.DoRawHttpRequestWithObjectMaskCalls, struct {
+		Path        string
+		Masks       []string
+		RequestType string
+		RequestBody *bytes.Buffer
+	}{path, masks, requestType, requestBody})
+	stub := fake.DoRawHttpRequestWithObjectMaskStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(path, masks, requestType, requestBody)
+	}
+
+	return fake.DoRawHttpRequestWithObjectMaskResult, fake.DoRawHttpRequestWithObjectMaskError
+}
+
+func (fake *FakeClient) DoRawHttpRequestWithObjectMaskWithContext(ctx context.Context, path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, error) {
+	fake.mutex.Lock()
+	fake.DoRawHttpRequestWithObjectMaskWithContextCalls = append(fake.DoRawHttpRequestWithObjectMaskWithContextCalls, struct {
+		Ctx         context.Context
+		Path        string
+		Masks       []string
+		RequestType string
+		RequestBody *bytes.Buffer
+	}{ctx, path, masks, requestType, requestBody})
+	stub := fake.DoRawHttpRequestWithObjectMaskWithContextStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, path, masks, requestType, requestBody)
+	}
+
+	return fake.DoRawHttpRequestWithObjectMaskWithContextResult, fake.DoRawHttpRequestWithObjectMaskWithContextError
+}
+
+func (fake *FakeClient) GenerateRequestBody(templateData interface{}) (*bytes.Buffer, error) {
+	fake.mutex.Lock()
+	fake.GenerateRequestBodyCalls = append(fake.GenerateRequestBodyCalls, struct {
+		TemplateData interface{}
+	}{templateData})
+	stub := fake.GenerateRequestBodyStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(templateData)
+	}
+
+	return fake.GenerateRequestBodyResult, fake.GenerateRequestBodyError
+}
+
+func (fake *FakeClient) GetService(name string) (softlayer.Service, error) {
+	fake.mutex.Lock()
+	fake.GetServiceCalls = append(fake.GetServiceCalls, struct {
+		Name string
+	}{name})
+	stub := fake.GetServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(name)
+	}
+
+	return fake.GetServiceResult, fake.GetServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Account_Service() (softlayer.SoftLayer_Account_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Account_ServiceCalls = append(fake.GetSoftLayer_Account_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Account_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Account_ServiceResult, fake.GetSoftLayer_Account_ServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Billing_Item_Cancellation_Request_Service() (softlayer.SoftLayer_Billing_Item_Cancellation_Request_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceCalls = append(fake.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceResult, fake.GetSoftLayer_Billing_Item_Cancellation_Request_ServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Hardware_Service() (softlayer.SoftLayer_Hardware_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Hardware_ServiceCalls = append(fake.GetSoftLayer_Hardware_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Hardware_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Hardware_ServiceResult, fake.GetSoftLayer_Hardware_ServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Network_Storage_Service() (softlayer.SoftLayer_Network_Storage_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Network_Storage_ServiceCalls = append(fake.GetSoftLayer_Network_Storage_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Network_Storage_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Network_Storage_ServiceResult, fake.GetSoftLayer_Network_Storage_ServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Order_Service() (softlayer.SoftLayer_Product_Order_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Product_Order_ServiceCalls = append(fake.GetSoftLayer_Product_Order_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Product_Order_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Product_Order_ServiceResult, fake.GetSoftLayer_Product_Order_ServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Product_Package_Service() (softlayer.SoftLayer_Product_Package_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Product_Package_ServiceCalls = append(fake.GetSoftLayer_Product_Package_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Product_Package_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Product_Package_ServiceResult, fake.GetSoftLayer_Product_Package_ServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Security_Ssh_Key_Service() (softlayer.SoftLayer_Security_Ssh_Key_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Security_Ssh_Key_ServiceCalls = append(fake.GetSoftLayer_Security_Ssh_Key_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Security_Ssh_Key_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Security_Ssh_Key_ServiceResult, fake.GetSoftLayer_Security_Ssh_Key_ServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Disk_Image_Service() (softlayer.SoftLayer_Virtual_Disk_Image_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Virtual_Disk_Image_ServiceCalls = append(fake.GetSoftLayer_Virtual_Disk_Image_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Virtual_Disk_Image_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Virtual_Disk_Image_ServiceResult, fake.GetSoftLayer_Virtual_Disk_Image_ServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_Service() (softlayer.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceCalls = append(fake.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceResult, fake.GetSoftLayer_Virtual_Guest_Block_Device_Template_Group_ServiceError
+}
+
+func (fake *FakeClient) GetSoftLayer_Virtual_Guest_Service() (softlayer.SoftLayer_Virtual_Guest_Service, error) {
+	fake.mutex.Lock()
+	fake.GetSoftLayer_Virtual_Guest_ServiceCalls = append(fake.GetSoftLayer_Virtual_Guest_ServiceCalls, struct{}{})
+	stub := fake.GetSoftLayer_Virtual_Guest_ServiceStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetSoftLayer_Virtual_Guest_ServiceResult, fake.GetSoftLayer_Virtual_Guest_ServiceError
+}
+
+func (fake *FakeClient) HasErrors(body map[string]interface{}) error {
+	fake.mutex.Lock()
+	fake.HasErrorsCalls = append(fake.HasErrorsCalls, struct {
+		Body map[string]interface{}
+	}{body})
+	stub := fake.HasErrorsStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(body)
+	}
+
+	return fake.HasErrorsError
+}
+
+func (fake *FakeClient) Invoke(ctx context.Context, invocation softlayer.Invocation, result interface{}) error {
+	fake.mutex.Lock()
+	fake.InvokeCalls = append(fake.InvokeCalls, struct {
+		Ctx        context.Context
+		Invocation softlayer.Invocation
+		Result     interface{}
+	}{ctx, invocation, result})
+	stub := fake.InvokeStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, invocation, result)
+	}
+
+	return fake.InvokeError
+}
@@ -0,0 +1,74 @@
+package softlayer_fakes_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	generator "github.com/maximilien/softlayer-go/generator"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	softlayerfakes "github.com/maximilien/softlayer-go/softlayer/fakes"
+)
+
+var _ = Describe("SoftLayer fakes", func() {
+	It("are up to date with the softlayer interfaces", func() {
+		wd, _ := os.Getwd()
+
+		files, err := generator.GenerateInterfaceFakes(filepath.Join(wd, ".."), "github.com/maximilien/softlayer-go/softlayer")
+		Expect(err).ToNot(HaveOccurred())
+
+		checkedIn, err := filepath.Glob(filepath.Join(wd, "*_fake.go"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(checkedIn).To(HaveLen(len(files)), "the fakes are out of date, regenerate them with bin/generate_fakes")
+
+		for path, source := range files {
+			expected, err := ioutil.ReadFile(filepath.Join(wd, path))
+			Expect(err).ToNot(HaveOccurred(), "%s is not generated, regenerate the fakes with bin/generate_fakes", path)
+			Expect(string(source)).To(Equal(string(expected)), "%s is out of date, regenerate the fakes with bin/generate_fakes", path)
+		}
+	})
+
+	Context("FakeSoftLayer_Virtual_Guest_Service", func() {
+		var (
+			fakeVirtualGuestService *softlayerfakes.FakeSoftLayer_Virtual_Guest_Service
+			virtualGuestService     softlayer.SoftLayer_Virtual_Guest_Service
+		)
+
+		BeforeEach(func() {
+			fakeVirtualGuestService = &softlayerfakes.FakeSoftLayer_Virtual_Guest_Service{}
+			virtualGuestService = fakeVirtualGuestService
+		})
+
+		It("returns the configured power state and records the call", func() {
+			fakeVirtualGuestService.GetPowerStateResult = datatypes.SoftLayer_Virtual_Guest_Power_State{KeyName: "RUNNING"}
+
+			powerState, err := virtualGuestService.GetPowerState(1234)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powerState.KeyName).To(Equal("RUNNING"))
+
+			Expect(fakeVirtualGuestService.GetPowerStateCalls).To(HaveLen(1))
+			Expect(fakeVirtualGuestService.GetPowerStateCalls[0].InstanceId).To(Equal(1234))
+		})
+
+		It("calls the stub when set", func() {
+			fakeVirtualGuestService.GetPowerStateStub = func(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error) {
+				if instanceId != 1234 {
+					return datatypes.SoftLayer_Virtual_Guest_Power_State{}, errors.New("fake-error")
+				}
+				return datatypes.SoftLayer_Virtual_Guest_Power_State{KeyName: "HALTED"}, nil
+			}
+
+			powerState, err := virtualGuestService.GetPowerState(1234)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(powerState.KeyName).To(Equal("HALTED"))
+
+			_, err = virtualGuestService.GetPowerState(5678)
+			Expect(err).To(MatchError("fake-error"))
+		})
+	})
+})
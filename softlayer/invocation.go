@@ -0,0 +1,46 @@
+package softlayer
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Invocation is a call to any method of any SoftLayer service, including the
+// ones without a service in this library, e.g.
+//
+//	Invocation{Service: "SoftLayer_Network_Subnet", Id: 1234, Method: "getObject"}
+type Invocation struct {
+	Service string
+
+	// Id is the init parameter of the call, i.e. the object the method is
+	// called on. It is left out of the call when 0.
+	Id int
+
+	Method string
+
+	// Parameters are the positional parameters of the method.
+	Parameters []interface{}
+
+	Options *RequestOptions
+}
+
+func (i Invocation) Validate() error {
+	if i.Service == "" {
+		return errors.New("softlayer-go: invocation has no service")
+	}
+
+	if i.Method == "" {
+		return errors.New("softlayer-go: invocation has no method")
+	}
+
+	return nil
+}
+
+// Path returns the REST path of the call, relative to the endpoint.
+func (i Invocation) Path() string {
+	if i.Id != 0 {
+		return fmt.Sprintf("%s/%d/%s.json", i.Service, i.Id, i.Method)
+	}
+
+	return fmt.Sprintf("%s/%s.json", i.Service, i.Method)
+}
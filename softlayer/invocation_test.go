@@ -0,0 +1,30 @@
+package softlayer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("Invocation", func() {
+	Context("#Path", func() {
+		It("includes the init parameter", func() {
+			invocation := softlayer.Invocation{Service: "SoftLayer_Network_Subnet", Id: 1234, Method: "getObject"}
+			Expect(invocation.Path()).To(Equal("SoftLayer_Network_Subnet/1234/getObject.json"))
+		})
+
+		It("leaves out a missing init parameter", func() {
+			invocation := softlayer.Invocation{Service: "SoftLayer_Account", Method: "getSubnets"}
+			Expect(invocation.Path()).To(Equal("SoftLayer_Account/getSubnets.json"))
+		})
+	})
+
+	Context("#Validate", func() {
+		It("requires a service and a method", func() {
+			Expect(softlayer.Invocation{Service: "SoftLayer_Account", Method: "getSubnets"}.Validate()).ToNot(HaveOccurred())
+			Expect(softlayer.Invocation{Method: "getSubnets"}.Validate()).To(HaveOccurred())
+			Expect(softlayer.Invocation{Service: "SoftLayer_Account"}.Validate()).To(HaveOccurred())
+		})
+	})
+})
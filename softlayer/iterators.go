@@ -0,0 +1,164 @@
+package softlayer
+
+import (
+	"context"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+// pageIterator walks through the results of the pages of a Pager. The typed
+// iterators below only convert the pages.
+type pageIterator struct {
+	pager  *Pager
+	index  int
+	length int
+}
+
+func (it *pageIterator) next(load func(page interface{}) int) bool {
+	it.index++
+	for it.index >= it.length {
+		if !it.pager.Next() {
+			return false
+		}
+
+		it.index = 0
+		it.length = load(it.pager.Page())
+	}
+
+	return true
+}
+
+// TotalItems returns the total number of results reported by the API, or -1
+// when it is not known (yet).
+func (it *pageIterator) TotalItems() int {
+	return it.pager.TotalItems()
+}
+
+func (it *pageIterator) Err() error {
+	return it.pager.Err()
+}
+
+// Close stops the iteration. It must be called when breaking out of the loop.
+func (it *pageIterator) Close() {
+	it.pager.Close()
+}
+
+type VirtualGuestIterator struct {
+	pageIterator
+	page []datatypes.SoftLayer_Virtual_Guest
+}
+
+func NewVirtualGuestIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions, fetch PageFetcher) *VirtualGuestIterator {
+	return &VirtualGuestIterator{pageIterator: pageIterator{pager: NewPager(ctx, options, pagerOptions, fetch), index: -1}}
+}
+
+func (it *VirtualGuestIterator) Next() bool {
+	return it.next(func(page interface{}) int {
+		it.page, _ = page.([]datatypes.SoftLayer_Virtual_Guest)
+		return len(it.page)
+	})
+}
+
+func (it *VirtualGuestIterator) Value() datatypes.SoftLayer_Virtual_Guest {
+	return it.page[it.index]
+}
+
+type NetworkStorageIterator struct {
+	pageIterator
+	page []datatypes.SoftLayer_Network_Storage
+}
+
+func NewNetworkStorageIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions, fetch PageFetcher) *NetworkStorageIterator {
+	return &NetworkStorageIterator{pageIterator: pageIterator{pager: NewPager(ctx, options, pagerOptions, fetch), index: -1}}
+}
+
+func (it *NetworkStorageIterator) Next() bool {
+	return it.next(func(page interface{}) int {
+		it.page, _ = page.([]datatypes.SoftLayer_Network_Storage)
+		return len(it.page)
+	})
+}
+
+func (it *NetworkStorageIterator) Value() datatypes.SoftLayer_Network_Storage {
+	return it.page[it.index]
+}
+
+type VirtualDiskImageIterator struct {
+	pageIterator
+	page []datatypes.SoftLayer_Virtual_Disk_Image
+}
+
+func NewVirtualDiskImageIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions, fetch PageFetcher) *VirtualDiskImageIterator {
+	return &VirtualDiskImageIterator{pageIterator: pageIterator{pager: NewPager(ctx, options, pagerOptions, fetch), index: -1}}
+}
+
+func (it *VirtualDiskImageIterator) Next() bool {
+	return it.next(func(page interface{}) int {
+		it.page, _ = page.([]datatypes.SoftLayer_Virtual_Disk_Image)
+		return len(it.page)
+	})
+}
+
+func (it *VirtualDiskImageIterator) Value() datatypes.SoftLayer_Virtual_Disk_Image {
+	return it.page[it.index]
+}
+
+type SshKeyIterator struct {
+	pageIterator
+	page []datatypes.SoftLayer_Security_Ssh_Key
+}
+
+func NewSshKeyIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions, fetch PageFetcher) *SshKeyIterator {
+	return &SshKeyIterator{pageIterator: pageIterator{pager: NewPager(ctx, options, pagerOptions, fetch), index: -1}}
+}
+
+func (it *SshKeyIterator) Next() bool {
+	return it.next(func(page interface{}) int {
+		it.page, _ = page.([]datatypes.SoftLayer_Security_Ssh_Key)
+		return len(it.page)
+	})
+}
+
+func (it *SshKeyIterator) Value() datatypes.SoftLayer_Security_Ssh_Key {
+	return it.page[it.index]
+}
+
+type BlockDeviceTemplateGroupIterator struct {
+	pageIterator
+	page []datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group
+}
+
+func NewBlockDeviceTemplateGroupIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions, fetch PageFetcher) *BlockDeviceTemplateGroupIterator {
+	return &BlockDeviceTemplateGroupIterator{pageIterator: pageIterator{pager: NewPager(ctx, options, pagerOptions, fetch), index: -1}}
+}
+
+func (it *BlockDeviceTemplateGroupIterator) Next() bool {
+	return it.next(func(page interface{}) int {
+		it.page, _ = page.([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group)
+		return len(it.page)
+	})
+}
+
+func (it *BlockDeviceTemplateGroupIterator) Value() datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group {
+	return it.page[it.index]
+}
+
+type HardwareIterator struct {
+	pageIterator
+	page []datatypes.SoftLayer_Hardware
+}
+
+func NewHardwareIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions, fetch PageFetcher) *HardwareIterator {
+	return &HardwareIterator{pageIterator: pageIterator{pager: NewPager(ctx, options, pagerOptions, fetch), index: -1}}
+}
+
+func (it *HardwareIterator) Next() bool {
+	return it.next(func(page interface{}) int {
+		it.page, _ = page.([]datatypes.SoftLayer_Hardware)
+		return len(it.page)
+	})
+}
+
+func (it *HardwareIterator) Value() datatypes.SoftLayer_Hardware {
+	return it.page[it.index]
+}
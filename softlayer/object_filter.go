@@ -0,0 +1,217 @@
+package softlayer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	FILTER_DATE_FORMAT = "01/02/2006 15:04:05"
+
+	SORT_ASC  = "ASC"
+	SORT_DESC = "DESC"
+)
+
+// ObjectFilter restricts, server side, the objects returned by an API call.
+// Paths start at the property of the called method, e.g. for
+// SoftLayer_Account::getVirtualGuests:
+//
+//	NewObjectFilter().
+//		Set("virtualGuests.datacenter.name", Equals("dal09")).
+//		Set("virtualGuests.tagReferences.tag.name", In("prod")).
+//		Set("virtualGuests.hostname", BeginsWith("web-")).
+//		OrderBy("virtualGuests.id", SORT_DESC)
+type ObjectFilter struct {
+	root map[string]interface{}
+}
+
+// FilterOperation is the condition applied to a property.
+type FilterOperation struct {
+	Operation interface{}    `json:"operation"`
+	Options   []FilterOption `json:"options,omitempty"`
+}
+
+type FilterOption struct {
+	Name  string        `json:"name"`
+	Value []interface{} `json:"value"`
+}
+
+func NewObjectFilter() *ObjectFilter {
+	return &ObjectFilter{root: map[string]interface{}{}}
+}
+
+// Set applies operation to the property at the dotted path, replacing any
+// previous operation on it and its options, but not the sort of OrderBy.
+func (of *ObjectFilter) Set(path string, operation FilterOperation) *ObjectFilter {
+	node := of.node(path)
+	node["operation"] = operation.Operation
+
+	options := append([]FilterOption{}, operation.Options...)
+	if sortOption, ok := findFilterOption(node, "sort"); ok {
+		options = append(options, sortOption)
+	}
+	setFilterOptions(node, options)
+
+	return of
+}
+
+// OrderBy sorts the results on the property at the dotted path, replacing any
+// previous sort on it. It can be combined with an operation set on the same
+// property.
+func (of *ObjectFilter) OrderBy(path string, direction string) *ObjectFilter {
+	node := of.node(path)
+	if _, ok := node["operation"]; !ok {
+		node["operation"] = "orderBy"
+	}
+
+	options := []FilterOption{}
+	existing, _ := node["options"].([]FilterOption)
+	for _, option := range existing {
+		if option.Name != "sort" {
+			options = append(options, option)
+		}
+	}
+	setFilterOptions(node, append(options, FilterOption{Name: "sort", Value: []interface{}{direction}}))
+
+	return of
+}
+
+func (of *ObjectFilter) IsEmpty() bool {
+	return of == nil || len(of.root) == 0
+}
+
+func (of *ObjectFilter) String() string {
+	if of.IsEmpty() {
+		return ""
+	}
+
+	encoded, err := json.Marshal(of.root)
+	if err != nil {
+		return ""
+	}
+
+	return string(encoded)
+}
+
+// Encode returns the filter escaped for use as the objectFilter query parameter.
+func (of *ObjectFilter) Encode() string {
+	return url.QueryEscape(of.String())
+}
+
+// Equals matches the value exactly.
+func Equals(value interface{}) FilterOperation {
+	return FilterOperation{Operation: value}
+}
+
+// EqualsIgnoreCase matches the value ignoring case (_=).
+func EqualsIgnoreCase(value string) FilterOperation {
+	return stringOperation("_=", value)
+}
+
+func NotEquals(value interface{}) FilterOperation {
+	return stringOperation("!=", value)
+}
+
+// Contains matches values containing the substring (~).
+func Contains(value string) FilterOperation {
+	return stringOperation("~", value)
+}
+
+// NotContains matches values not containing the substring (!~).
+func NotContains(value string) FilterOperation {
+	return stringOperation("!~", value)
+}
+
+func BeginsWith(value string) FilterOperation {
+	return stringOperation("^=", value)
+}
+
+func EndsWith(value string) FilterOperation {
+	return stringOperation("$=", value)
+}
+
+func GreaterThan(value interface{}) FilterOperation {
+	return stringOperation(">", value)
+}
+
+func GreaterThanOrEqual(value interface{}) FilterOperation {
+	return stringOperation(">=", value)
+}
+
+func LessThan(value interface{}) FilterOperation {
+	return stringOperation("<", value)
+}
+
+func LessThanOrEqual(value interface{}) FilterOperation {
+	return stringOperation("<=", value)
+}
+
+func IsNull() FilterOperation {
+	return FilterOperation{Operation: "is null"}
+}
+
+func NotNull() FilterOperation {
+	return FilterOperation{Operation: "not null"}
+}
+
+// In matches any of the values.
+func In(values ...interface{}) FilterOperation {
+	return FilterOperation{
+		Operation: "in",
+		Options:   []FilterOption{{Name: "data", Value: values}},
+	}
+}
+
+// BetweenDate matches dates between start and end, both included.
+func BetweenDate(start time.Time, end time.Time) FilterOperation {
+	return FilterOperation{
+		Operation: "betweenDate",
+		Options: []FilterOption{
+			{Name: "startDate", Value: []interface{}{start.Format(FILTER_DATE_FORMAT)}},
+			{Name: "endDate", Value: []interface{}{end.Format(FILTER_DATE_FORMAT)}},
+		},
+	}
+}
+
+//Private methods
+
+func (of *ObjectFilter) node(path string) map[string]interface{} {
+	node := of.root
+	for _, name := range strings.Split(path, ".") {
+		child, ok := node[name].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			node[name] = child
+		}
+		node = child
+	}
+
+	return node
+}
+
+func findFilterOption(node map[string]interface{}, name string) (FilterOption, bool) {
+	options, _ := node["options"].([]FilterOption)
+	for _, option := range options {
+		if option.Name == name {
+			return option, true
+		}
+	}
+
+	return FilterOption{}, false
+}
+
+func setFilterOptions(node map[string]interface{}, options []FilterOption) {
+	if len(options) == 0 {
+		delete(node, "options")
+		return
+	}
+
+	node["options"] = options
+}
+
+func stringOperation(operator string, value interface{}) FilterOperation {
+	return FilterOperation{Operation: fmt.Sprintf("%s %v", operator, value)}
+}
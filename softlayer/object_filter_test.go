@@ -0,0 +1,126 @@
+package softlayer_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("ObjectFilter", func() {
+	Context("#Set", func() {
+		It("nests the operations under their dotted paths", func() {
+			objectFilter := softlayer.NewObjectFilter().
+				Set("virtualGuests.datacenter.name", softlayer.Equals("dal09")).
+				Set("virtualGuests.tagReferences.tag.name", softlayer.Equals("prod")).
+				Set("virtualGuests.hostname", softlayer.BeginsWith("web-"))
+
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"datacenter":{"name":{"operation":"dal09"}},"hostname":{"operation":"^= web-"},"tagReferences":{"tag":{"name":{"operation":"prod"}}}}}`))
+		})
+
+		It("replaces a previous operation on the same path", func() {
+			objectFilter := softlayer.NewObjectFilter().
+				Set("virtualGuests.id", softlayer.Equals(1)).
+				Set("virtualGuests.id", softlayer.Equals(2))
+
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"id":{"operation":2}}}`))
+		})
+
+		It("replaces the options of a previous operation on the same path", func() {
+			objectFilter := softlayer.NewObjectFilter().
+				Set("virtualGuests.id", softlayer.In(1, 2)).
+				Set("virtualGuests.id", softlayer.Equals(3))
+
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"id":{"operation":3}}}`))
+		})
+
+		It("is empty without operations", func() {
+			objectFilter := softlayer.NewObjectFilter()
+			Expect(objectFilter.IsEmpty()).To(BeTrue())
+			Expect(objectFilter.String()).To(Equal(""))
+
+			var nilFilter *softlayer.ObjectFilter
+			Expect(nilFilter.IsEmpty()).To(BeTrue())
+		})
+	})
+
+	Context("operations", func() {
+		It("prefixes string operators", func() {
+			Expect(softlayer.EqualsIgnoreCase("DAL09")).To(Equal(softlayer.FilterOperation{Operation: "_= DAL09"}))
+			Expect(softlayer.Contains("bosh")).To(Equal(softlayer.FilterOperation{Operation: "~ bosh"}))
+			Expect(softlayer.NotContains("bosh")).To(Equal(softlayer.FilterOperation{Operation: "!~ bosh"}))
+			Expect(softlayer.EndsWith(".com")).To(Equal(softlayer.FilterOperation{Operation: "$= .com"}))
+			Expect(softlayer.GreaterThanOrEqual(4)).To(Equal(softlayer.FilterOperation{Operation: ">= 4"}))
+			Expect(softlayer.LessThan(1024)).To(Equal(softlayer.FilterOperation{Operation: "< 1024"}))
+		})
+
+		It("renders in with its data option", func() {
+			objectFilter := softlayer.NewObjectFilter().Set("virtualGuests.id", softlayer.In(1, 2, 3))
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"id":{"operation":"in","options":[{"name":"data","value":[1,2,3]}]}}}`))
+		})
+
+		It("renders betweenDate with its start and end dates", func() {
+			start := time.Date(2015, time.March, 1, 0, 0, 0, 0, time.UTC)
+			end := time.Date(2015, time.March, 31, 23, 59, 59, 0, time.UTC)
+
+			objectFilter := softlayer.NewObjectFilter().Set("virtualGuests.createDate", softlayer.BetweenDate(start, end))
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"createDate":{"operation":"betweenDate","options":[{"name":"startDate","value":["03/01/2015 00:00:00"]},{"name":"endDate","value":["03/31/2015 23:59:59"]}]}}}`))
+		})
+	})
+
+	Context("#OrderBy", func() {
+		It("sorts on a property without operation", func() {
+			objectFilter := softlayer.NewObjectFilter().OrderBy("virtualGuests.id", softlayer.SORT_DESC)
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"id":{"operation":"orderBy","options":[{"name":"sort","value":["DESC"]}]}}}`))
+		})
+
+		It("keeps the operation set on the same property", func() {
+			objectFilter := softlayer.NewObjectFilter().
+				Set("virtualGuests.hostname", softlayer.BeginsWith("web-")).
+				OrderBy("virtualGuests.hostname", softlayer.SORT_ASC)
+
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"hostname":{"operation":"^= web-","options":[{"name":"sort","value":["ASC"]}]}}}`))
+		})
+
+		It("merges the sort with the options of the operation set before", func() {
+			objectFilter := softlayer.NewObjectFilter().
+				Set("virtualGuests.id", softlayer.In(1, 2)).
+				OrderBy("virtualGuests.id", softlayer.SORT_DESC)
+
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"id":{"operation":"in","options":[{"name":"data","value":[1,2]},{"name":"sort","value":["DESC"]}]}}}`))
+		})
+
+		It("keeps the sort when an operation with options is set after", func() {
+			objectFilter := softlayer.NewObjectFilter().
+				OrderBy("virtualGuests.id", softlayer.SORT_DESC).
+				Set("virtualGuests.id", softlayer.In(1, 2))
+
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"id":{"operation":"in","options":[{"name":"data","value":[1,2]},{"name":"sort","value":["DESC"]}]}}}`))
+		})
+
+		It("keeps the sort when an operation without options is set after", func() {
+			objectFilter := softlayer.NewObjectFilter().
+				OrderBy("virtualGuests.id", softlayer.SORT_DESC).
+				Set("virtualGuests.id", softlayer.Equals(3))
+
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"id":{"operation":3,"options":[{"name":"sort","value":["DESC"]}]}}}`))
+		})
+
+		It("replaces a previous sort on the same property", func() {
+			objectFilter := softlayer.NewObjectFilter().
+				OrderBy("virtualGuests.id", softlayer.SORT_DESC).
+				OrderBy("virtualGuests.id", softlayer.SORT_ASC)
+
+			Expect(objectFilter.String()).To(Equal(`{"virtualGuests":{"id":{"operation":"orderBy","options":[{"name":"sort","value":["ASC"]}]}}}`))
+		})
+	})
+
+	Context("#Encode", func() {
+		It("escapes the filter for the query string", func() {
+			objectFilter := softlayer.NewObjectFilter().Set("virtualGuests.hostname", softlayer.BeginsWith("web-"))
+			Expect(objectFilter.Encode()).To(Equal("%7B%22virtualGuests%22%3A%7B%22hostname%22%3A%7B%22operation%22%3A%22%5E%3D+web-%22%7D%7D%7D"))
+		})
+	})
+})
@@ -0,0 +1,373 @@
+package softlayer
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ObjectMask selects the properties, including relational ones, returned by
+// an API call, e.g.
+//
+//	NewObjectMask("id", "hostname", "billingItem.recurringFee").
+//		Nest("networkComponents", NewObjectMask("primaryIpAddress"))
+//
+// renders as mask[id,hostname,billingItem[recurringFee],networkComponents[primaryIpAddress]].
+type ObjectMask struct {
+	filtered bool
+	root     *maskNode
+
+	// verbatim is the mask sent as is, see ParseLegacyObjectMasks.
+	verbatim string
+}
+
+type maskNode struct {
+	name     string
+	children []*maskNode
+
+	// local is set on a node named on its own, e.g. billingItem, which selects
+	// all its local properties even once nested properties are added to it.
+	local bool
+}
+
+func NewObjectMask(properties ...string) *ObjectMask {
+	return (&ObjectMask{root: &maskNode{}}).Add(properties...)
+}
+
+// NewFilteredObjectMask is NewObjectMask rendering as filteredMask[...], which
+// applies the object filter to the relational properties as well.
+func NewFilteredObjectMask(properties ...string) *ObjectMask {
+	objectMask := NewObjectMask(properties...)
+	objectMask.filtered = true
+
+	return objectMask
+}
+
+// ParseObjectMask parses a list of items separated by ',' or ';' and merges
+// them. An item is written as mask[...], filteredMask[...], mask.property.path
+// or as a property which may have nested properties, e.g.
+// networkComponents[primaryIpAddress].
+func ParseObjectMask(mask string) (*ObjectMask, error) {
+	parser := &maskParser{input: strings.TrimSpace(mask)}
+
+	objectMask, err := parser.parse()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Invalid object mask '%s': %s", mask, err.Error()))
+	}
+
+	return objectMask, nil
+}
+
+// ParseLegacyObjectMasks returns the mask of the masks given as strings, e.g.
+// to DoRawHttpRequestWithObjectMask. They are merged when they all parse and
+// are all filteredMask[...] or all not, otherwise they are sent verbatim,
+// joined with ';' as before masks were parsed. A verbatim mask has no
+// Properties and ignores the properties added to it.
+func ParseLegacyObjectMasks(masks []string) *ObjectMask {
+	objectMask := NewObjectMask()
+	for i, mask := range masks {
+		parsedMask, err := ParseObjectMask(mask)
+		if err != nil || i > 0 && parsedMask.filtered != objectMask.filtered {
+			return &ObjectMask{root: &maskNode{}, verbatim: strings.Join(masks, ";")}
+		}
+
+		objectMask.filtered = parsedMask.filtered
+		objectMask.Merge(parsedMask)
+	}
+
+	return objectMask
+}
+
+// Add adds dotted property paths, e.g. operatingSystem.passwords.password, to
+// the mask. A leading "mask." is ignored.
+func (om *ObjectMask) Add(properties ...string) *ObjectMask {
+	for _, property := range properties {
+		property = strings.TrimPrefix(strings.TrimSpace(property), "mask.")
+		if property == "" {
+			continue
+		}
+
+		om.root.add(strings.Split(property, ".")).local = true
+	}
+
+	return om
+}
+
+// Nest adds the properties of nested under the relational property.
+func (om *ObjectMask) Nest(property string, nested *ObjectMask) *ObjectMask {
+	property = strings.TrimPrefix(strings.TrimSpace(property), "mask.")
+
+	node := om.root.add(strings.Split(property, "."))
+	if nested != nil {
+		node.merge(nested.root)
+	}
+
+	return om
+}
+
+// Merge adds all the properties of other to the mask.
+func (om *ObjectMask) Merge(other *ObjectMask) *ObjectMask {
+	if other != nil {
+		om.root.merge(other.root)
+	}
+
+	return om
+}
+
+func (om *ObjectMask) IsEmpty() bool {
+	return om == nil || om.verbatim == "" && len(om.root.children) == 0
+}
+
+func (om *ObjectMask) String() string {
+	if om.IsEmpty() {
+		return ""
+	}
+
+	if om.verbatim != "" {
+		return om.verbatim
+	}
+
+	prefix := "mask"
+	if om.filtered {
+		prefix = "filteredMask"
+	}
+
+	return prefix + "[" + om.root.childrenString() + "]"
+}
+
+// Properties returns the property paths selected by the mask, e.g.
+// billingItem.recurringFee, in the order they were added.
+func (om *ObjectMask) Properties() []string {
+	if om.IsEmpty() || om.verbatim != "" {
+		return []string{}
+	}
+
+	return om.root.properties("")
+}
+
+// Encode returns the mask escaped for use as the objectMask query parameter.
+func (om *ObjectMask) Encode() string {
+	return url.QueryEscape(om.String())
+}
+
+//Private methods
+
+func (mn *maskNode) add(path []string) *maskNode {
+	node := mn
+	for _, name := range path {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		node = node.child(name)
+	}
+
+	return node
+}
+
+func (mn *maskNode) child(name string) *maskNode {
+	for _, child := range mn.children {
+		if child.name == name {
+			return child
+		}
+	}
+
+	child := &maskNode{name: name}
+	mn.children = append(mn.children, child)
+
+	return child
+}
+
+func (mn *maskNode) merge(other *maskNode) {
+	for _, otherChild := range other.children {
+		child := mn.child(otherChild.name)
+		child.local = child.local || otherChild.local
+		child.merge(otherChild)
+	}
+}
+
+func (mn *maskNode) properties(prefix string) []string {
+	properties := []string{}
+	for _, child := range mn.children {
+		if child.local || len(child.children) == 0 {
+			properties = append(properties, prefix+child.name)
+		}
+		if len(child.children) == 0 {
+			continue
+		}
+
+		properties = append(properties, child.properties(prefix+child.name+".")...)
+	}
+
+	return properties
+}
+
+func (mn *maskNode) childrenString() string {
+	children := []string{}
+	for _, child := range mn.children {
+		if child.local || len(child.children) == 0 {
+			children = append(children, child.name)
+		}
+		if len(child.children) > 0 {
+			children = append(children, child.name+"["+child.childrenString()+"]")
+		}
+	}
+
+	return strings.Join(children, ",")
+}
+
+type maskParser struct {
+	input    string
+	position int
+}
+
+func (mp *maskParser) parse() (*ObjectMask, error) {
+	objectMask := NewObjectMask()
+	if mp.input == "" {
+		return objectMask, nil
+	}
+
+	wrappers := map[string]bool{}
+	for {
+		wrapper, err := mp.parseWrappedItem(objectMask.root)
+		if err != nil {
+			return nil, err
+		}
+
+		wrappers[wrapper] = true
+		if wrappers["mask"] && wrappers["filteredMask"] {
+			return nil, mp.errorf("mask and filteredMask cannot be mixed")
+		}
+		objectMask.filtered = wrappers["filteredMask"]
+
+		if mp.consume(',') || mp.consume(';') {
+			continue
+		}
+
+		return objectMask, mp.expectEnd()
+	}
+}
+
+// parseWrappedItem parses an item of the top level list into root and returns
+// its mask or filteredMask wrapper, if any.
+func (mp *maskParser) parseWrappedItem(root *maskNode) (string, error) {
+	mp.skipSpaces()
+
+	for _, wrapper := range []string{"filteredMask", "mask"} {
+		rest := mp.input[mp.position:]
+		if !strings.HasPrefix(rest, wrapper) {
+			continue
+		}
+
+		rest = rest[len(wrapper):]
+		if strings.HasPrefix(rest, ".") {
+			mp.position += len(wrapper) + 1
+			return wrapper, mp.parseItem(root)
+		}
+
+		if strings.HasPrefix(rest, "[") {
+			mp.position += len(wrapper) + 1
+			if err := mp.parseList(root); err != nil {
+				return "", err
+			}
+
+			if !mp.consume(']') {
+				return "", mp.errorf("expected ']'")
+			}
+
+			return wrapper, nil
+		}
+	}
+
+	return "", mp.parseItem(root)
+}
+
+func (mp *maskParser) parseList(parent *maskNode) error {
+	for {
+		if err := mp.parseItem(parent); err != nil {
+			return err
+		}
+
+		if !mp.consume(',') {
+			return nil
+		}
+	}
+}
+
+func (mp *maskParser) parseItem(parent *maskNode) error {
+	node := parent
+	for {
+		name := mp.parseName()
+		if name == "" {
+			return mp.errorf("expected a property name")
+		}
+		node = node.child(name)
+
+		if !mp.consume('.') {
+			break
+		}
+	}
+
+	if !mp.consume('[') {
+		node.local = true
+		return nil
+	}
+
+	if err := mp.parseList(node); err != nil {
+		return err
+	}
+
+	if !mp.consume(']') {
+		return mp.errorf("expected ']'")
+	}
+
+	return nil
+}
+
+func (mp *maskParser) parseName() string {
+	mp.skipSpaces()
+
+	start := mp.position
+	for mp.position < len(mp.input) {
+		c := mp.input[mp.position]
+		if !(c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')) {
+			break
+		}
+		mp.position++
+	}
+
+	return mp.input[start:mp.position]
+}
+
+func (mp *maskParser) consume(c byte) bool {
+	mp.skipSpaces()
+
+	if mp.position < len(mp.input) && mp.input[mp.position] == c {
+		mp.position++
+		return true
+	}
+
+	return false
+}
+
+func (mp *maskParser) skipSpaces() {
+	for mp.position < len(mp.input) && mp.input[mp.position] == ' ' {
+		mp.position++
+	}
+}
+
+func (mp *maskParser) expectEnd() error {
+	mp.skipSpaces()
+
+	if mp.position != len(mp.input) {
+		return mp.errorf("unexpected '%c'", mp.input[mp.position])
+	}
+
+	return nil
+}
+
+func (mp *maskParser) errorf(format string, args ...interface{}) error {
+	return errors.New(fmt.Sprintf("%s at position %d", fmt.Sprintf(format, args...), mp.position))
+}
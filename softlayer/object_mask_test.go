@@ -0,0 +1,181 @@
+package softlayer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("ObjectMask", func() {
+	Context("#NewObjectMask", func() {
+		It("renders the properties in a mask", func() {
+			objectMask := softlayer.NewObjectMask("id", "hostname")
+			Expect(objectMask.String()).To(Equal("mask[id,hostname]"))
+		})
+
+		It("nests dotted relational properties", func() {
+			objectMask := softlayer.NewObjectMask(
+				"id",
+				"operatingSystem.passwords.password",
+				"operatingSystem.passwords.username",
+				"mask.billingItem.recurringFee",
+			)
+			Expect(objectMask.String()).To(Equal("mask[id,operatingSystem[passwords[password,username]],billingItem[recurringFee]]"))
+		})
+
+		It("is empty without properties", func() {
+			objectMask := softlayer.NewObjectMask()
+			Expect(objectMask.IsEmpty()).To(BeTrue())
+			Expect(objectMask.String()).To(Equal(""))
+		})
+	})
+
+	Context("#NewFilteredObjectMask", func() {
+		It("renders a filteredMask", func() {
+			objectMask := softlayer.NewFilteredObjectMask("id", "tagReferences.tag.name")
+			Expect(objectMask.String()).To(Equal("filteredMask[id,tagReferences[tag[name]]]"))
+		})
+	})
+
+	Context("#Nest", func() {
+		It("nests a mask under a relational property", func() {
+			objectMask := softlayer.NewObjectMask().
+				Nest("networkComponents", softlayer.NewObjectMask("primaryIpAddress")).
+				Nest("billingItem", softlayer.NewObjectMask("recurringFee"))
+			Expect(objectMask.String()).To(Equal("mask[networkComponents[primaryIpAddress],billingItem[recurringFee]]"))
+		})
+	})
+
+	Context("#Merge", func() {
+		It("merges the properties of both masks", func() {
+			objectMask := softlayer.NewObjectMask("id", "billingItem.id").Merge(softlayer.NewObjectMask("billingItem.recurringFee"))
+			Expect(objectMask.String()).To(Equal("mask[id,billingItem[id,recurringFee]]"))
+		})
+
+		It("keeps the local properties of a property named on its own", func() {
+			objectMask := softlayer.NewObjectMask("billingItem").Merge(softlayer.NewObjectMask("billingItem.id"))
+			Expect(objectMask.String()).To(Equal("mask[billingItem,billingItem[id]]"))
+		})
+	})
+
+	Context("#ParseLegacyObjectMasks", func() {
+		It("merges the masks", func() {
+			objectMask := softlayer.ParseLegacyObjectMasks([]string{"mask.id", "mask[billingItem.recurringFee]"})
+			Expect(objectMask.String()).To(Equal("mask[id,billingItem[recurringFee]]"))
+		})
+
+		It("keeps a filteredMask", func() {
+			objectMask := softlayer.ParseLegacyObjectMasks([]string{"filteredMask[id]", "filteredMask[hostname]"})
+			Expect(objectMask.String()).To(Equal("filteredMask[id,hostname]"))
+		})
+
+		It("sends the masks verbatim when one does not parse", func() {
+			objectMask := softlayer.ParseLegacyObjectMasks([]string{"id", "networkComponents[primaryIpAddress"})
+			Expect(objectMask.IsEmpty()).To(BeFalse())
+			Expect(objectMask.String()).To(Equal("id;networkComponents[primaryIpAddress"))
+			Expect(objectMask.Properties()).To(BeEmpty())
+		})
+
+		It("sends the masks verbatim when only some are filtered", func() {
+			objectMask := softlayer.ParseLegacyObjectMasks([]string{"mask[id]", "filteredMask[hostname]"})
+			Expect(objectMask.String()).To(Equal("mask[id];filteredMask[hostname]"))
+		})
+
+		It("keeps the local properties of a property named on its own", func() {
+			objectMask := softlayer.ParseLegacyObjectMasks([]string{"billingItem", "billingItem.id"})
+			Expect(objectMask.String()).To(Equal("mask[billingItem,billingItem[id]]"))
+		})
+
+		It("is empty without masks", func() {
+			Expect(softlayer.ParseLegacyObjectMasks(nil).IsEmpty()).To(BeTrue())
+		})
+	})
+
+	Context("#Properties", func() {
+		It("returns the property paths of the mask", func() {
+			objectMask, err := softlayer.ParseObjectMask("mask[id,billingItem[id,orderItem.order.id],location]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectMask.Properties()).To(Equal([]string{"id", "billingItem.id", "billingItem.orderItem.order.id", "location"}))
+		})
+
+		It("is empty for an empty mask", func() {
+			Expect(softlayer.NewObjectMask().Properties()).To(BeEmpty())
+		})
+	})
+
+	Context("#Encode", func() {
+		It("escapes the mask for a query string", func() {
+			objectMask := softlayer.NewObjectMask("id").Nest("networkComponents", softlayer.NewObjectMask("primaryIpAddress"))
+			Expect(objectMask.Encode()).To(Equal("mask%5Bid%2CnetworkComponents%5BprimaryIpAddress%5D%5D"))
+		})
+	})
+
+	Context("#ParseObjectMask", func() {
+		It("parses a mask[...] with nested properties", func() {
+			objectMask, err := softlayer.ParseObjectMask("mask[networkComponents[primaryIpAddress],billingItem[recurringFee]]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectMask.String()).To(Equal("mask[networkComponents[primaryIpAddress],billingItem[recurringFee]]"))
+		})
+
+		It("parses a filteredMask[...]", func() {
+			objectMask, err := softlayer.ParseObjectMask("filteredMask[id, hostname]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectMask.String()).To(Equal("filteredMask[id,hostname]"))
+		})
+
+		It("parses a mask. property path", func() {
+			objectMask, err := softlayer.ParseObjectMask("mask.operatingSystem.passwords")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectMask.String()).To(Equal("mask[operatingSystem[passwords]]"))
+		})
+
+		It("parses properties separated by ';'", func() {
+			objectMask, err := softlayer.ParseObjectMask("id;billingItem.id;billingItem.orderItem.order.id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectMask.String()).To(Equal("mask[id,billingItem[id,orderItem[order[id]]]]"))
+		})
+
+		It("parses dotted properties inside brackets", func() {
+			objectMask, err := softlayer.ParseObjectMask("mask[billingItem.orderItem[id,order.id]]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectMask.String()).To(Equal("mask[billingItem[orderItem[id,order[id]]]]"))
+		})
+
+		It("strips the mask. prefix of each item separated by ';'", func() {
+			objectMask, err := softlayer.ParseObjectMask("mask.id;mask.billingItem.id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectMask.String()).To(Equal("mask[id,billingItem[id]]"))
+		})
+
+		It("merges the mask[...] items separated by ';'", func() {
+			objectMask, err := softlayer.ParseObjectMask("mask[id];mask[billingItem.id]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectMask.String()).To(Equal("mask[id,billingItem[id]]"))
+		})
+
+		It("fails on mixed mask[...] and filteredMask[...] items", func() {
+			_, err := softlayer.ParseObjectMask("mask[id];filteredMask[hostname]")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cannot be mixed"))
+		})
+
+		It("keeps the local properties of a property named on its own", func() {
+			objectMask, err := softlayer.ParseObjectMask("billingItem;billingItem.id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(objectMask.String()).To(Equal("mask[billingItem,billingItem[id]]"))
+			Expect(objectMask.Properties()).To(Equal([]string{"billingItem", "billingItem.id"}))
+		})
+
+		It("fails on unbalanced brackets", func() {
+			_, err := softlayer.ParseObjectMask("mask[networkComponents[primaryIpAddress]")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expected ']'"))
+		})
+
+		It("fails on unexpected characters", func() {
+			_, err := softlayer.ParseObjectMask("mask[id]&objectFilter=")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,230 @@
+package softlayer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const (
+	TRANSACTION_STATUS_COMPLETE = "COMPLETE"
+	TRANSACTION_STATUS_FAILED   = "FAILED"
+
+	DEFAULT_OPERATION_POLL_INTERVAL = 10 * time.Second
+	DEFAULT_OPERATION_START_TIMEOUT = 5 * time.Minute
+)
+
+// TransactionFetcher returns the transaction as currently known by the API,
+// and false once it is no longer active. A transaction without an id is still
+// awaited, e.g. the one created by a reload.
+type TransactionFetcher func(ctx context.Context, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction) (datatypes.SoftLayer_Provisioning_Version1_Transaction, bool, error)
+
+type OperationOptions struct {
+	// PollInterval is DEFAULT_OPERATION_POLL_INTERVAL when 0.
+	PollInterval time.Duration
+
+	// StartTimeout is how long a transaction without an id is awaited,
+	// DEFAULT_OPERATION_START_TIMEOUT when 0.
+	StartTimeout time.Duration
+}
+
+// OperationStatus is the status of a transaction when it was polled.
+type OperationStatus struct {
+	Name         string
+	FriendlyName string
+
+	// ElapsedSeconds is the time the transaction has been in this status.
+	ElapsedSeconds int
+
+	// EstimatedRemaining is the average duration of the status minus its
+	// elapsed time, 0 when the average duration is unknown or exceeded.
+	EstimatedRemaining time.Duration
+}
+
+// TransactionFailedError is returned by an Operation whose transaction
+// reached the FAILED status.
+type TransactionFailedError struct {
+	Transaction datatypes.SoftLayer_Provisioning_Version1_Transaction
+}
+
+func (e *TransactionFailedError) Error() string {
+	return fmt.Sprintf("softlayer-go: transaction %d failed", e.Transaction.Id)
+}
+
+// TransactionNotStartedError is returned by an Operation whose awaited
+// transaction did not appear within OperationOptions.StartTimeout, e.g. as it
+// completed before being polled.
+type TransactionNotStartedError struct {
+	StartTimeout time.Duration
+}
+
+func (e *TransactionNotStartedError) Error() string {
+	return fmt.Sprintf("softlayer-go: no transaction started within %s", e.StartTimeout)
+}
+
+// Operation follows a transaction returned by the API, e.g. by
+// AttachDiskImage, until it is no longer active or its status is COMPLETE or
+// FAILED. The statuses of the transaction are sent on Statuses, which must be
+// read until it is closed, unless Wait is called instead.
+type Operation struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	transaction  datatypes.SoftLayer_Provisioning_Version1_Transaction
+	fetch        TransactionFetcher
+	pollInterval time.Duration
+	startTimeout time.Duration
+
+	statuses chan OperationStatus
+	err      error
+}
+
+func NewOperation(ctx context.Context, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options OperationOptions, fetch TransactionFetcher) *Operation {
+	operation := &Operation{
+		transaction:  transaction,
+		fetch:        fetch,
+		pollInterval: options.PollInterval,
+		startTimeout: options.StartTimeout,
+		statuses:     make(chan OperationStatus),
+	}
+	operation.ctx, operation.cancel = context.WithCancel(ctx)
+
+	if operation.pollInterval == 0 {
+		operation.pollInterval = DEFAULT_OPERATION_POLL_INTERVAL
+	}
+
+	if operation.startTimeout == 0 {
+		operation.startTimeout = DEFAULT_OPERATION_START_TIMEOUT
+	}
+
+	go operation.poll()
+
+	return operation
+}
+
+// Statuses receives the status of the transaction each time its name or
+// elapsed time changes. It is closed when the operation ends.
+func (o *Operation) Statuses() <-chan OperationStatus {
+	return o.statuses
+}
+
+// Wait discards the statuses until the operation ends and returns Err.
+func (o *Operation) Wait() error {
+	for range o.statuses {
+	}
+
+	return o.Err()
+}
+
+// Err returns why the operation ended, nil when the transaction completed. It
+// is only set once Statuses is closed.
+func (o *Operation) Err() error {
+	return o.err
+}
+
+// Transaction returns the transaction as last polled, once Statuses is closed.
+func (o *Operation) Transaction() datatypes.SoftLayer_Provisioning_Version1_Transaction {
+	return o.transaction
+}
+
+// Cancel stops polling the transaction. It does not cancel the transaction.
+func (o *Operation) Cancel() {
+	o.cancel()
+}
+
+//Private methods
+
+func (o *Operation) poll() {
+	defer close(o.statuses)
+	defer o.cancel()
+
+	startDeadline := time.Now().Add(o.startTimeout)
+	lastStatus := OperationStatus{ElapsedSeconds: -1}
+	for {
+		transaction, active, err := o.fetch(o.ctx, o.transaction)
+		if err != nil {
+			o.err = err
+			return
+		}
+
+		if !active {
+			return
+		}
+
+		o.transaction = transaction
+		if transaction.Id == 0 {
+			if !time.Now().Before(startDeadline) {
+				o.err = &TransactionNotStartedError{StartTimeout: o.startTimeout}
+				return
+			}
+
+			if !o.sleep() {
+				return
+			}
+
+			continue
+		}
+
+		status := newOperationStatus(transaction)
+		if status.Name != lastStatus.Name || status.ElapsedSeconds != lastStatus.ElapsedSeconds {
+			lastStatus = status
+
+			select {
+			case o.statuses <- status:
+			case <-o.ctx.Done():
+				o.err = o.ctx.Err()
+				return
+			}
+		}
+
+		switch status.Name {
+		case TRANSACTION_STATUS_COMPLETE:
+			return
+		case TRANSACTION_STATUS_FAILED:
+			o.err = &TransactionFailedError{Transaction: transaction}
+			return
+		}
+
+		if !o.sleep() {
+			return
+		}
+	}
+}
+
+// sleep waits for the next poll, false when the operation was cancelled.
+func (o *Operation) sleep() bool {
+	select {
+	case <-time.After(o.pollInterval):
+		return true
+	case <-o.ctx.Done():
+		o.err = o.ctx.Err()
+		return false
+	}
+}
+
+//Private functions
+
+func newOperationStatus(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction) OperationStatus {
+	status := OperationStatus{
+		Name:           transaction.TransactionStatus.Name,
+		FriendlyName:   transaction.TransactionStatus.FriendlyName,
+		ElapsedSeconds: transaction.ElapsedSeconds,
+	}
+
+	// The API gives the average duration of a status in minutes, e.g. ".42".
+	averageMinutes, err := strconv.ParseFloat(strings.TrimSpace(transaction.TransactionStatus.AverageDuration), 64)
+	if err != nil {
+		return status
+	}
+
+	remaining := time.Duration(averageMinutes*float64(time.Minute)) - time.Duration(transaction.ElapsedSeconds)*time.Second
+	if remaining > 0 {
+		status.EstimatedRemaining = remaining
+	}
+
+	return status
+}
@@ -0,0 +1,205 @@
+package softlayer_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("Operation", func() {
+	var (
+		transaction datatypes.SoftLayer_Provisioning_Version1_Transaction
+
+		lock       sync.Mutex
+		polls      []datatypes.SoftLayer_Provisioning_Version1_Transaction
+		repeatLast bool
+		fetchErr   error
+
+		fetch   softlayer.TransactionFetcher
+		options softlayer.OperationOptions
+	)
+
+	newTransaction := func(status string, elapsedSeconds int, averageDuration string) datatypes.SoftLayer_Provisioning_Version1_Transaction {
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			Id:             1234,
+			GuestId:        5678,
+			ElapsedSeconds: elapsedSeconds,
+			TransactionStatus: datatypes.TransactionStatus{
+				Name:            status,
+				FriendlyName:    "Fake " + status,
+				AverageDuration: averageDuration,
+			},
+		}
+	}
+
+	collect := func(operation *softlayer.Operation) []softlayer.OperationStatus {
+		statuses := []softlayer.OperationStatus{}
+		for status := range operation.Statuses() {
+			statuses = append(statuses, status)
+		}
+
+		return statuses
+	}
+
+	BeforeEach(func() {
+		transaction = newTransaction("CLOUD_ATTACH_DISK", 0, "1")
+		polls = []datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+		repeatLast = false
+		fetchErr = nil
+
+		fetch = func(ctx context.Context, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction) (datatypes.SoftLayer_Provisioning_Version1_Transaction, bool, error) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			if fetchErr != nil {
+				return transaction, false, fetchErr
+			}
+
+			if len(polls) == 0 {
+				return transaction, false, nil
+			}
+
+			polled := polls[0]
+			if len(polls) > 1 || !repeatLast {
+				polls = polls[1:]
+			}
+
+			return polled, true, nil
+		}
+
+		options = softlayer.OperationOptions{PollInterval: time.Millisecond}
+	})
+
+	It("streams the status changes until the transaction is no longer active", func() {
+		polls = []datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			newTransaction("CLOUD_ATTACH_DISK", 30, "1"),
+			newTransaction("CLOUD_ATTACH_DISK", 30, "1"),
+			newTransaction("CLOUD_ATTACH_DISK", 45, "1"),
+			newTransaction("CLOUD_REBOOT", 0, ".5"),
+		}
+
+		operation := softlayer.NewOperation(context.Background(), transaction, options, fetch)
+
+		statuses := collect(operation)
+		Expect(operation.Err()).ToNot(HaveOccurred())
+
+		Expect(statuses).To(Equal([]softlayer.OperationStatus{
+			{Name: "CLOUD_ATTACH_DISK", FriendlyName: "Fake CLOUD_ATTACH_DISK", ElapsedSeconds: 30, EstimatedRemaining: 30 * time.Second},
+			{Name: "CLOUD_ATTACH_DISK", FriendlyName: "Fake CLOUD_ATTACH_DISK", ElapsedSeconds: 45, EstimatedRemaining: 15 * time.Second},
+			{Name: "CLOUD_REBOOT", FriendlyName: "Fake CLOUD_REBOOT", ElapsedSeconds: 0, EstimatedRemaining: 30 * time.Second},
+		}))
+		Expect(operation.Transaction().TransactionStatus.Name).To(Equal("CLOUD_REBOOT"))
+	})
+
+	It("waits without a status while the transaction has no id", func() {
+		awaited := datatypes.SoftLayer_Provisioning_Version1_Transaction{GuestId: 5678}
+		polls = []datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			awaited,
+			awaited,
+			newTransaction(softlayer.TRANSACTION_STATUS_COMPLETE, 0, ""),
+		}
+
+		operation := softlayer.NewOperation(context.Background(), awaited, options, fetch)
+		statuses := collect(operation)
+		Expect(operation.Err()).ToNot(HaveOccurred())
+
+		Expect(statuses).To(HaveLen(1))
+		Expect(statuses[0].Name).To(Equal(softlayer.TRANSACTION_STATUS_COMPLETE))
+		Expect(operation.Transaction().Id).To(Equal(1234))
+	})
+
+	It("fails when the transaction has no id past the start timeout", func() {
+		awaited := datatypes.SoftLayer_Provisioning_Version1_Transaction{GuestId: 5678}
+		polls = []datatypes.SoftLayer_Provisioning_Version1_Transaction{awaited}
+		repeatLast = true
+		options.StartTimeout = 20 * time.Millisecond
+
+		operation := softlayer.NewOperation(context.Background(), awaited, options, fetch)
+		statuses := collect(operation)
+		Expect(statuses).To(BeEmpty())
+
+		err := operation.Err()
+		Expect(err).To(MatchError("softlayer-go: no transaction started within 20ms"))
+
+		_, ok := err.(*softlayer.TransactionNotStartedError)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("ends when the transaction is COMPLETE", func() {
+		polls = []datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			newTransaction("CLOUD_ATTACH_DISK", 0, "1"),
+			newTransaction(softlayer.TRANSACTION_STATUS_COMPLETE, 0, ""),
+		}
+
+		operation := softlayer.NewOperation(context.Background(), transaction, options, fetch)
+		statuses := collect(operation)
+		Expect(operation.Err()).ToNot(HaveOccurred())
+
+		Expect(statuses).To(HaveLen(2))
+		Expect(statuses[1].Name).To(Equal(softlayer.TRANSACTION_STATUS_COMPLETE))
+		Expect(statuses[1].EstimatedRemaining).To(Equal(time.Duration(0)))
+	})
+
+	It("fails when the transaction is FAILED", func() {
+		polls = []datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			newTransaction(softlayer.TRANSACTION_STATUS_FAILED, 0, ""),
+		}
+
+		operation := softlayer.NewOperation(context.Background(), transaction, options, fetch)
+		err := operation.Wait()
+		Expect(err).To(MatchError("softlayer-go: transaction 1234 failed"))
+
+		failedErr, ok := err.(*softlayer.TransactionFailedError)
+		Expect(ok).To(BeTrue())
+		Expect(failedErr.Transaction.GuestId).To(Equal(5678))
+	})
+
+	It("does not estimate the remaining time once the average duration is exceeded", func() {
+		polls = []datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			newTransaction(softlayer.TRANSACTION_STATUS_COMPLETE, 90, "1"),
+		}
+
+		statuses := collect(softlayer.NewOperation(context.Background(), transaction, options, fetch))
+		Expect(statuses[0].EstimatedRemaining).To(Equal(time.Duration(0)))
+	})
+
+	It("fails on the errors of the fetcher", func() {
+		fetchErr = errors.New("fake-error")
+
+		operation := softlayer.NewOperation(context.Background(), transaction, options, fetch)
+		Expect(operation.Wait()).To(MatchError("fake-error"))
+	})
+
+	It("stops polling when cancelled", func() {
+		polls = []datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			newTransaction("CLOUD_ATTACH_DISK", 0, "1"),
+		}
+		repeatLast = true
+
+		operation := softlayer.NewOperation(context.Background(), transaction, options, fetch)
+		Eventually(operation.Statuses()).Should(Receive())
+
+		operation.Cancel()
+		Expect(operation.Wait()).To(Equal(context.Canceled))
+	})
+
+	It("stops polling when its context is done", func() {
+		polls = []datatypes.SoftLayer_Provisioning_Version1_Transaction{
+			newTransaction("CLOUD_ATTACH_DISK", 0, "1"),
+		}
+		repeatLast = true
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		operation := softlayer.NewOperation(ctx, transaction, options, fetch)
+		Expect(operation.Wait()).To(Equal(context.DeadlineExceeded))
+	})
+})
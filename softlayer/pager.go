@@ -0,0 +1,183 @@
+package softlayer
+
+import (
+	"context"
+)
+
+const DEFAULT_PAGE_SIZE = 100
+
+type PagerOptions struct {
+	// PageSize is the number of results requested per call, DEFAULT_PAGE_SIZE
+	// when 0.
+	PageSize int
+
+	// Concurrency is the number of pages fetched at the same time once the
+	// total number of results is known. Pages are fetched one at a time when
+	// it is 0 or 1.
+	Concurrency int
+}
+
+// PageFetcher fetches the page of a list call selected by options.ResultLimit
+// and returns it along with its number of results.
+type PageFetcher func(ctx context.Context, options *RequestOptions) (interface{}, int, error)
+
+// Pager walks through the pages of a list call. A page is only requested when
+// the previous one has been consumed, or ahead of time when Concurrency is
+// set. Close must be called when the caller stops before the last page.
+type Pager struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	options     RequestOptions
+	fetch       PageFetcher
+	pageSize    int
+	concurrency int
+
+	offset     int
+	totalItems int
+	lastCount  int
+	pending    []chan pageResult
+
+	page interface{}
+	done bool
+	err  error
+}
+
+type pageResult struct {
+	page       interface{}
+	count      int
+	totalItems int
+	err        error
+}
+
+func NewPager(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions, fetch PageFetcher) *Pager {
+	pager := &Pager{
+		fetch:       fetch,
+		pageSize:    pagerOptions.PageSize,
+		concurrency: pagerOptions.Concurrency,
+		totalItems:  -1,
+	}
+	pager.ctx, pager.cancel = context.WithCancel(ctx)
+
+	if options != nil {
+		pager.options = *options
+	}
+
+	if pager.pageSize <= 0 {
+		pager.pageSize = DEFAULT_PAGE_SIZE
+	}
+
+	if pager.concurrency < 1 {
+		pager.concurrency = 1
+	}
+
+	return pager
+}
+
+// Next fetches the next page and reports whether there is one.
+func (p *Pager) Next() bool {
+	if p.done {
+		return false
+	}
+
+	if len(p.pending) == 0 {
+		if !p.hasMore() {
+			p.finish(nil)
+			return false
+		}
+		p.schedule()
+	}
+
+	var result pageResult
+	select {
+	case result = <-p.pending[0]:
+	case <-p.ctx.Done():
+		p.finish(p.ctx.Err())
+		return false
+	}
+	p.pending = p.pending[1:]
+
+	if result.err != nil {
+		p.finish(result.err)
+		return false
+	}
+
+	if result.totalItems >= 0 {
+		p.totalItems = result.totalItems
+	}
+	p.lastCount = result.count
+
+	if result.count == 0 {
+		p.finish(nil)
+		return false
+	}
+
+	p.page = result.page
+
+	for p.totalItems >= 0 && len(p.pending) < p.concurrency-1 && p.offset < p.totalItems {
+		p.schedule()
+	}
+
+	return true
+}
+
+// Page returns the page fetched by the last call to Next.
+func (p *Pager) Page() interface{} {
+	return p.page
+}
+
+// TotalItems returns the total number of results reported by the API, or -1
+// when it is not known (yet).
+func (p *Pager) TotalItems() int {
+	return p.totalItems
+}
+
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// Close stops the pager and cancels the pages being fetched ahead.
+func (p *Pager) Close() {
+	p.finish(nil)
+}
+
+//Private methods
+
+func (p *Pager) hasMore() bool {
+	if p.offset == 0 {
+		return true
+	}
+
+	if p.totalItems >= 0 {
+		return p.offset < p.totalItems
+	}
+
+	return p.lastCount >= p.pageSize
+}
+
+func (p *Pager) schedule() {
+	options := p.options
+	options.ResultLimit = &ResultLimit{Offset: p.offset, Limit: p.pageSize}
+	options.TotalItems = -1
+	p.offset += p.pageSize
+
+	results := make(chan pageResult, 1)
+	p.pending = append(p.pending, results)
+
+	go func() {
+		page, count, err := p.fetch(p.ctx, &options)
+		results <- pageResult{page: page, count: count, totalItems: options.TotalItems, err: err}
+	}()
+}
+
+func (p *Pager) finish(err error) {
+	if p.done {
+		return
+	}
+
+	p.done = true
+	p.err = err
+	p.page = nil
+	p.pending = nil
+	p.cancel()
+}
@@ -0,0 +1,156 @@
+package softlayer_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+var _ = Describe("Pager", func() {
+	var (
+		items          []int
+		sendTotalItems bool
+		failAtOffset   int
+
+		lock           sync.Mutex
+		requestedPages []softlayer.ResultLimit
+
+		fetch softlayer.PageFetcher
+	)
+
+	BeforeEach(func() {
+		items = []int{}
+		for i := 0; i < 25; i++ {
+			items = append(items, i)
+		}
+		sendTotalItems = true
+		failAtOffset = -1
+		requestedPages = []softlayer.ResultLimit{}
+
+		fetch = func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+			lock.Lock()
+			requestedPages = append(requestedPages, *options.ResultLimit)
+			lock.Unlock()
+
+			if options.ResultLimit.Offset == failAtOffset {
+				return nil, 0, errors.New("fake-error")
+			}
+
+			if sendTotalItems {
+				options.TotalItems = len(items)
+			}
+
+			start := options.ResultLimit.Offset
+			end := start + options.ResultLimit.Limit
+			if start > len(items) {
+				start = len(items)
+			}
+			if end > len(items) {
+				end = len(items)
+			}
+
+			return items[start:end], end - start, nil
+		}
+	})
+
+	collect := func(pager *softlayer.Pager) []int {
+		results := []int{}
+		for pager.Next() {
+			results = append(results, pager.Page().([]int)...)
+		}
+		return results
+	}
+
+	It("fetches the pages one at a time", func() {
+		pager := softlayer.NewPager(context.Background(), nil, softlayer.PagerOptions{PageSize: 10}, fetch)
+
+		Expect(collect(pager)).To(Equal(items))
+		Expect(pager.Err()).ToNot(HaveOccurred())
+		Expect(pager.TotalItems()).To(Equal(25))
+		Expect(requestedPages).To(Equal([]softlayer.ResultLimit{{0, 10}, {10, 10}, {20, 10}}))
+	})
+
+	It("fetches lazily", func() {
+		pager := softlayer.NewPager(context.Background(), nil, softlayer.PagerOptions{PageSize: 10}, fetch)
+		defer pager.Close()
+
+		Expect(requestedPages).To(BeEmpty())
+		Expect(pager.Next()).To(BeTrue())
+		Expect(requestedPages).To(HaveLen(1))
+	})
+
+	It("stops on a short page without total items", func() {
+		sendTotalItems = false
+		pager := softlayer.NewPager(context.Background(), nil, softlayer.PagerOptions{PageSize: 10}, fetch)
+
+		Expect(collect(pager)).To(Equal(items))
+		Expect(pager.TotalItems()).To(Equal(-1))
+		Expect(requestedPages).To(HaveLen(3))
+	})
+
+	It("keeps the other request options", func() {
+		filter := softlayer.NewObjectFilter().Set("virtualGuests.id", softlayer.GreaterThan(0))
+		fetch = func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+			Expect(options.Filter).To(Equal(filter))
+			return []int{}, 0, nil
+		}
+
+		pager := softlayer.NewPager(context.Background(), &softlayer.RequestOptions{Filter: filter}, softlayer.PagerOptions{}, fetch)
+		Expect(pager.Next()).To(BeFalse())
+	})
+
+	It("fetches the pages concurrently in order", func() {
+		pager := softlayer.NewPager(context.Background(), nil, softlayer.PagerOptions{PageSize: 5, Concurrency: 3}, fetch)
+
+		Expect(collect(pager)).To(Equal(items))
+		Expect(requestedPages).To(HaveLen(5))
+	})
+
+	It("stops fetching when closed", func() {
+		pager := softlayer.NewPager(context.Background(), nil, softlayer.PagerOptions{PageSize: 5}, fetch)
+
+		Expect(pager.Next()).To(BeTrue())
+		pager.Close()
+
+		Expect(pager.Next()).To(BeFalse())
+		Expect(pager.Err()).ToNot(HaveOccurred())
+		Expect(requestedPages).To(HaveLen(1))
+	})
+
+	It("returns the error of a page", func() {
+		failAtOffset = 10
+		pager := softlayer.NewPager(context.Background(), nil, softlayer.PagerOptions{PageSize: 10}, fetch)
+
+		Expect(collect(pager)).To(Equal(items[:10]))
+		Expect(pager.Err()).To(MatchError("fake-error"))
+	})
+
+	Context("VirtualGuestIterator", func() {
+		It("iterates over the results of all pages", func() {
+			fetch := func(ctx context.Context, options *softlayer.RequestOptions) (interface{}, int, error) {
+				options.TotalItems = 3
+				if options.ResultLimit.Offset == 0 {
+					return []datatypes.SoftLayer_Virtual_Guest{{Id: 1}, {Id: 2}}, 2, nil
+				}
+				return []datatypes.SoftLayer_Virtual_Guest{{Id: 3}}, 1, nil
+			}
+
+			iterator := softlayer.NewVirtualGuestIterator(context.Background(), nil, softlayer.PagerOptions{PageSize: 2}, fetch)
+
+			ids := []int{}
+			for iterator.Next() {
+				ids = append(ids, iterator.Value().Id)
+			}
+
+			Expect(iterator.Err()).ToNot(HaveOccurred())
+			Expect(iterator.TotalItems()).To(Equal(3))
+			Expect(ids).To(Equal([]int{1, 2, 3}))
+		})
+	})
+})
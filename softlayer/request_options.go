@@ -0,0 +1,28 @@
+package softlayer
+
+import "fmt"
+
+// RequestOptions are the optional settings of a single API call.
+type RequestOptions struct {
+	// Mask replaces the default object mask of the call.
+	Mask *ObjectMask
+
+	Filter *ObjectFilter
+
+	// ResultLimit restricts a list call to a page of its results.
+	ResultLimit *ResultLimit
+
+	// TotalItems is set by the client to the SoftLayer-Total-Items header of
+	// the response, when the API sends it.
+	TotalItems int
+}
+
+// ResultLimit is a page of Limit results starting at Offset.
+type ResultLimit struct {
+	Offset int
+	Limit  int
+}
+
+func (rl *ResultLimit) String() string {
+	return fmt.Sprintf("%d,%d", rl.Offset, rl.Limit)
+}
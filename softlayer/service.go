@@ -1,5 +1,7 @@
 package softlayer
 
+//go:generate go run ../main/slgo_fakes/slgo_fakes.go -source . -output fakes
+
 type Service interface {
 	GetName() string
 }
@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,13 +10,36 @@ type SoftLayer_Account_Service interface {
 	Service
 
 	GetAccountStatus() (datatypes.SoftLayer_Account_Status, error)
+	GetAccountStatusWithContext(ctx context.Context) (datatypes.SoftLayer_Account_Status, error)
 	GetVirtualGuests() ([]datatypes.SoftLayer_Virtual_Guest, error)
+	GetVirtualGuestsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Guest, error)
+	GetVirtualGuestsWithOptions(ctx context.Context, options *RequestOptions) ([]datatypes.SoftLayer_Virtual_Guest, error)
+	GetVirtualGuestsIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions) *VirtualGuestIterator
 	GetNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error)
+	GetNetworkStorageWithContext(ctx context.Context) ([]datatypes.SoftLayer_Network_Storage, error)
+	GetNetworkStorageWithOptions(ctx context.Context, options *RequestOptions) ([]datatypes.SoftLayer_Network_Storage, error)
+	GetNetworkStorageIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions) *NetworkStorageIterator
 	GetIscsiNetworkStorage() ([]datatypes.SoftLayer_Network_Storage, error)
+	GetIscsiNetworkStorageWithContext(ctx context.Context) ([]datatypes.SoftLayer_Network_Storage, error)
+	GetIscsiNetworkStorageWithOptions(ctx context.Context, options *RequestOptions) ([]datatypes.SoftLayer_Network_Storage, error)
+	GetIscsiNetworkStorageIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions) *NetworkStorageIterator
 	GetVirtualDiskImages() ([]datatypes.SoftLayer_Virtual_Disk_Image, error)
+	GetVirtualDiskImagesWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Disk_Image, error)
+	GetVirtualDiskImagesWithOptions(ctx context.Context, options *RequestOptions) ([]datatypes.SoftLayer_Virtual_Disk_Image, error)
+	GetVirtualDiskImagesIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions) *VirtualDiskImageIterator
 	GetSshKeys() ([]datatypes.SoftLayer_Security_Ssh_Key, error)
+	GetSshKeysWithContext(ctx context.Context) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
+	GetSshKeysWithOptions(ctx context.Context, options *RequestOptions) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
+	GetSshKeysIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions) *SshKeyIterator
 	GetBlockDeviceTemplateGroups() ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	GetBlockDeviceTemplateGroupsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	GetBlockDeviceTemplateGroupsWithOptions(ctx context.Context, options *RequestOptions) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	GetBlockDeviceTemplateGroupsIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions) *BlockDeviceTemplateGroupIterator
 	GetDatacentersWithSubnetAllocations() ([]datatypes.SoftLayer_Location, error)
+	GetDatacentersWithSubnetAllocationsWithContext(ctx context.Context) ([]datatypes.SoftLayer_Location, error)
 
 	GetHardware() ([]datatypes.SoftLayer_Hardware, error)
+	GetHardwareWithContext(ctx context.Context) ([]datatypes.SoftLayer_Hardware, error)
+	GetHardwareWithOptions(ctx context.Context, options *RequestOptions) ([]datatypes.SoftLayer_Hardware, error)
+	GetHardwareIterator(ctx context.Context, options *RequestOptions, pagerOptions PagerOptions) *HardwareIterator
 }
@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,4 +10,5 @@ type SoftLayer_Billing_Item_Cancellation_Request_Service interface {
 	Service
 
 	CreateObject(request datatypes.SoftLayer_Billing_Item_Cancellation_Request) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
+	CreateObjectWithContext(ctx context.Context, request datatypes.SoftLayer_Billing_Item_Cancellation_Request) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
 }
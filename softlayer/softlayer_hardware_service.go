@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,5 +10,8 @@ type SoftLayer_Hardware_Service interface {
 	Service
 
 	CreateObject(template datatypes.SoftLayer_Hardware_Template) (datatypes.SoftLayer_Hardware, error)
+	CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Hardware_Template) (datatypes.SoftLayer_Hardware, error)
 	GetObject(id string) (datatypes.SoftLayer_Hardware, error)
+	GetObjectWithContext(ctx context.Context, id string) (datatypes.SoftLayer_Hardware, error)
+	GetObjectWithOptions(ctx context.Context, id string, options *RequestOptions) (datatypes.SoftLayer_Hardware, error)
 }
@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,6 +10,9 @@ type SoftLayer_Network_Storage_Service interface {
 	Service
 
 	CreateIscsiVolume(size int, location string) (datatypes.SoftLayer_Network_Storage, error)
+	CreateIscsiVolumeWithContext(ctx context.Context, size int, location string) (datatypes.SoftLayer_Network_Storage, error)
 	DeleteIscsiVolume(volumeId int, immediateCancellationFlag bool) error
+	DeleteIscsiVolumeWithContext(ctx context.Context, volumeId int, immediateCancellationFlag bool) error
 	GetIscsiVolume(volumeId int) (datatypes.SoftLayer_Network_Storage, error)
+	GetIscsiVolumeWithContext(ctx context.Context, volumeId int) (datatypes.SoftLayer_Network_Storage, error)
 }
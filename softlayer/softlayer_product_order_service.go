@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,4 +10,5 @@ type SoftLayer_Product_Order_Service interface {
 	Service
 
 	PlaceOrder(order datatypes.SoftLayer_Product_Order) (datatypes.SoftLayer_Product_Order_Receipt, error)
+	PlaceOrderWithContext(ctx context.Context, order datatypes.SoftLayer_Product_Order) (datatypes.SoftLayer_Product_Order_Receipt, error)
 }
@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,4 +10,6 @@ type SoftLayer_Product_Package_Service interface {
 	Service
 
 	GetItemPrices(packageId int) ([]datatypes.SoftLayer_Item_Price, error)
+	GetItemPricesWithContext(ctx context.Context, packageId int) ([]datatypes.SoftLayer_Item_Price, error)
+	GetItemPricesWithOptions(ctx context.Context, packageId int, options *RequestOptions) ([]datatypes.SoftLayer_Item_Price, error)
 }
@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,7 +10,10 @@ type SoftLayer_Security_Ssh_Key_Service interface {
 	Service
 
 	CreateObject(template datatypes.SoftLayer_Security_Ssh_Key) (datatypes.SoftLayer_Security_Ssh_Key, error)
+	CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Security_Ssh_Key) (datatypes.SoftLayer_Security_Ssh_Key, error)
 	DeleteObject(sshKeyId int) (bool, error)
+	DeleteObjectWithContext(ctx context.Context, sshKeyId int) (bool, error)
 
 	GetSoftwarePasswords(sshKeyId int) ([]datatypes.SoftLayer_Software_Component_Password, error)
+	GetSoftwarePasswordsWithContext(ctx context.Context, sshKeyId int) ([]datatypes.SoftLayer_Software_Component_Password, error)
 }
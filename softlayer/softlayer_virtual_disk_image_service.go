@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,4 +10,5 @@ type SoftLayer_Virtual_Disk_Image_Service interface {
 	Service
 
 	GetObject(id int) (datatypes.SoftLayer_Virtual_Disk_Image, error)
+	GetObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Disk_Image, error)
 }
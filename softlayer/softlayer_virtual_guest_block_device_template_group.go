@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,17 +10,30 @@ type SoftLayer_Virtual_Guest_Block_Device_Template_Group_Service interface {
 	Service
 
 	DeleteObject(id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	DeleteObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 
 	GetObject(id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	GetObjectWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
 	GetDatacenters(id int) ([]datatypes.SoftLayer_Location, error)
+	GetDatacentersWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Location, error)
 	GetSshKeys(id int) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
+	GetSshKeysWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
 	GetStatus(id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status, error)
+	GetStatusWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group_Status, error)
 
 	GetStorageLocations(id int) ([]datatypes.SoftLayer_Location, error)
+	GetStorageLocationsWithContext(ctx context.Context, id int) ([]datatypes.SoftLayer_Location, error)
 
 	GetImageType(id int) (datatypes.SoftLayer_Image_Type, error)
+	GetImageTypeWithContext(ctx context.Context, id int) (datatypes.SoftLayer_Image_Type, error)
 	GetImageTypeKeyName(id int) (string, error)
+	GetImageTypeKeyNameWithContext(ctx context.Context, id int) (string, error)
 
 	CreateFromExternalSource(configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	CreateFromExternalSourceWithContext(ctx context.Context, configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
 	CopyToExternalSource(configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (bool, error)
+	CopyToExternalSourceWithContext(ctx context.Context, configuration datatypes.SoftLayer_Container_Virtual_Guest_Block_Device_Template_Configuration) (bool, error)
+
+	TrackTransaction(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options OperationOptions) *Operation
+	TrackTransactionWithContext(ctx context.Context, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options OperationOptions) *Operation
 }
@@ -1,6 +1,8 @@
 package softlayer
 
 import (
+	"context"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
@@ -8,43 +10,94 @@ type SoftLayer_Virtual_Guest_Service interface {
 	Service
 
 	ActivatePrivatePort(instanceId int) (bool, error)
+	ActivatePrivatePortWithContext(ctx context.Context, instanceId int) (bool, error)
 	ActivatePublicPort(instanceId int) (bool, error)
+	ActivatePublicPortWithContext(ctx context.Context, instanceId int) (bool, error)
 	AttachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	AttachDiskImageWithContext(ctx context.Context, instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	AttachEphemeralDisk(instanceId int, diskSize int) error
+	AttachEphemeralDiskWithContext(ctx context.Context, instanceId int, diskSize int) error
 
+	CaptureImage(instanceId int, template datatypes.SoftLayer_Container_Disk_Image_Capture_Template, options OperationOptions) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	CaptureImageWithContext(ctx context.Context, instanceId int, template datatypes.SoftLayer_Container_Disk_Image_Capture_Template, options OperationOptions) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
 	CheckHostDiskAvailability(instanceId int, diskCapacity int) (bool, error)
+	CheckHostDiskAvailabilityWithContext(ctx context.Context, instanceId int, diskCapacity int) (bool, error)
 	ConfigureMetadataDisk(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	ConfigureMetadataDiskWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	CreateObject(template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error)
+	CreateObjectWithContext(ctx context.Context, template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error)
+	CreateArchiveTransaction(instanceId int, groupName string, blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device, note string) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
+	CreateArchiveTransactionWithContext(ctx context.Context, instanceId int, groupName string, blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device, note string) (datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
 
 	DeleteObject(instanceId int) (bool, error)
+	DeleteObjectWithContext(ctx context.Context, instanceId int) (bool, error)
 	DetachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	DetachDiskImageWithContext(ctx context.Context, instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 
 	EditObject(instanceId int, template datatypes.SoftLayer_Virtual_Guest) (bool, error)
+	EditObjectWithContext(ctx context.Context, instanceId int, template datatypes.SoftLayer_Virtual_Guest) (bool, error)
 
 	IsPingable(instanceId int) (bool, error)
+	IsPingableWithContext(ctx context.Context, instanceId int) (bool, error)
 
 	GetActiveTransaction(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	GetActiveTransactionWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	GetActiveTransactions(instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	GetActiveTransactionsWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	GetBlockDevices(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device, error)
+	GetBlockDevicesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device, error)
+	GetBlockDevicesWithOptions(ctx context.Context, instanceId int, options *RequestOptions) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device, error)
 	GetNetworkVlans(instanceId int) ([]datatypes.SoftLayer_Network_Vlan, error)
+	GetNetworkVlansWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Network_Vlan, error)
 	GetObject(instanceId int) (datatypes.SoftLayer_Virtual_Guest, error)
+	GetObjectWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest, error)
+	GetObjectWithOptions(ctx context.Context, instanceId int, options *RequestOptions) (datatypes.SoftLayer_Virtual_Guest, error)
 	GetPrimaryIpAddress(instanceId int) (string, error)
+	GetPrimaryIpAddressWithContext(ctx context.Context, instanceId int) (string, error)
 	GetPowerState(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error)
+	GetPowerStateWithContext(ctx context.Context, instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error)
 	GetSshKeys(instanceId int) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
+	GetSshKeysWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
 	GetTagReferences(instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error)
+	GetTagReferencesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error)
 	GetUpgradeItemPrices(instanceId int) ([]datatypes.SoftLayer_Item_Price, error)
+	GetUpgradeItemPricesWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Item_Price, error)
 	GetUserData(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Attribute, error)
+	GetUserDataWithContext(ctx context.Context, instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Attribute, error)
 
 	PowerCycle(instanceId int) (bool, error)
+	PowerCycleWithContext(ctx context.Context, instanceId int) (bool, error)
 	PowerOff(instanceId int) (bool, error)
+	PowerOffWithContext(ctx context.Context, instanceId int) (bool, error)
 	PowerOffSoft(instanceId int) (bool, error)
+	PowerOffSoftWithContext(ctx context.Context, instanceId int) (bool, error)
 	PowerOn(instanceId int) (bool, error)
+	PowerOnWithContext(ctx context.Context, instanceId int) (bool, error)
 
 	RebootDefault(instanceId int) (bool, error)
+	RebootDefaultWithContext(ctx context.Context, instanceId int) (bool, error)
 	RebootSoft(instanceId int) (bool, error)
+	RebootSoftWithContext(ctx context.Context, instanceId int) (bool, error)
 	RebootHard(instanceId int) (bool, error)
+	RebootHardWithContext(ctx context.Context, instanceId int) (bool, error)
+	ReloadOperatingSystem(instanceId int, config ReloadConfig, options OperationOptions) (*Operation, error)
+	ReloadOperatingSystemWithContext(ctx context.Context, instanceId int, config ReloadConfig, options OperationOptions) (*Operation, error)
 
 	SetMetadata(instanceId int, metadata string) (bool, error)
+	SetMetadataWithContext(ctx context.Context, instanceId int, metadata string) (bool, error)
 	SetTags(instanceId int, tags []string) (bool, error)
+	SetTagsWithContext(ctx context.Context, instanceId int, tags []string) (bool, error)
 	ShutdownPrivatePort(instanceId int) (bool, error)
+	ShutdownPrivatePortWithContext(ctx context.Context, instanceId int) (bool, error)
 	ShutdownPublicPort(instanceId int) (bool, error)
+	ShutdownPublicPortWithContext(ctx context.Context, instanceId int) (bool, error)
+
+	TrackTransaction(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options OperationOptions) *Operation
+	TrackTransactionWithContext(ctx context.Context, transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, options OperationOptions) *Operation
+
+	Upgrade(instanceId int, options UpgradeOptions) (datatypes.SoftLayer_Product_Order_Receipt, error)
+	UpgradeWithContext(ctx context.Context, instanceId int, options UpgradeOptions) (datatypes.SoftLayer_Product_Order_Receipt, error)
+
+	WaitUntilReady(instanceId int, options ReadyOptions) error
+	WaitUntilReadyWithContext(ctx context.Context, instanceId int, options ReadyOptions) error
 }
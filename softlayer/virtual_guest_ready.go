@@ -0,0 +1,91 @@
+package softlayer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const (
+	DEFAULT_READY_TIMEOUT       = 30 * time.Minute
+	DEFAULT_READY_POLL_INTERVAL = 10 * time.Second
+
+	POWER_STATE_RUNNING = "RUNNING"
+)
+
+// ReadyOptions configure how SoftLayer_Virtual_Guest_Service#WaitUntilReady
+// polls a virtual guest.
+type ReadyOptions struct {
+	// Timeout is DEFAULT_READY_TIMEOUT and PollInterval is
+	// DEFAULT_READY_POLL_INTERVAL when 0.
+	Timeout      time.Duration
+	PollInterval time.Duration
+
+	// Pingable also requires the virtual guest to answer to ping.
+	Pingable bool
+
+	// Progress, when set, is called with the state of the virtual guest after
+	// each poll.
+	Progress func(state ReadyState)
+}
+
+// ReadyState is the state of a virtual guest being waited for. The checks
+// are made in order, the fields of the ones after the first unmet check are
+// left unset.
+type ReadyState struct {
+	ActiveTransactions []datatypes.SoftLayer_Provisioning_Version1_Transaction
+	PowerState         string
+	PrimaryIpAddress   string
+	Pingable           bool
+
+	Ready   bool
+	Elapsed time.Duration
+}
+
+// String describes what the virtual guest is waiting for, e.g.
+// "1 active transaction (CLOUD_CONFIGURE_METADATA_DISK)".
+func (rs ReadyState) String() string {
+	switch {
+	case rs.Ready:
+		return "ready"
+	case len(rs.ActiveTransactions) > 0:
+		names := []string{}
+		for _, transaction := range rs.ActiveTransactions {
+			if transaction.TransactionStatus.Name != "" {
+				names = append(names, transaction.TransactionStatus.Name)
+			}
+		}
+
+		description := fmt.Sprintf("%d active transaction", len(rs.ActiveTransactions))
+		if len(rs.ActiveTransactions) > 1 {
+			description += "s"
+		}
+		if len(names) > 0 {
+			description += " (" + strings.Join(names, ", ") + ")"
+		}
+
+		return description
+	case rs.PowerState == "":
+		return "unknown state"
+	case rs.PowerState != POWER_STATE_RUNNING:
+		return fmt.Sprintf("power state %s", rs.PowerState)
+	case rs.PrimaryIpAddress == "":
+		return "no primary IP address"
+	}
+
+	return "not pingable"
+}
+
+// NotReadyError is returned when a virtual guest is not ready before the
+// timeout. State is the last state it was seen in.
+type NotReadyError struct {
+	InstanceId int
+	Timeout    time.Duration
+	State      ReadyState
+}
+
+func (e *NotReadyError) Error() string {
+	return fmt.Sprintf("softlayer-go: virtual guest %d not ready after %s: %s", e.InstanceId, e.Timeout, e.State)
+}
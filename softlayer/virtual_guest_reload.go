@@ -0,0 +1,26 @@
+package softlayer
+
+// ReloadConfig configures the operating system installed by
+// SoftLayer_Virtual_Guest_Service#ReloadOperatingSystem. The current
+// operating system is reinstalled when neither an image template nor an
+// operating system reference code is set, setting both is an error.
+type ReloadConfig struct {
+	// ImageTemplateGlobalIdentifier is the GUID of an image template of the
+	// account, sent as the id of its template group.
+	ImageTemplateGlobalIdentifier string
+
+	// OperatingSystemReferenceCode, e.g. UBUNTU_LATEST, is sent as the
+	// operating system item price of the virtual guest package with this
+	// reference code.
+	OperatingSystemReferenceCode string
+
+	SshKeyIds []int
+
+	// PostInstallScriptUri is the URI of the script run once the operating
+	// system is installed.
+	PostInstallScriptUri string
+
+	// CustomProvisionScriptUri is the URI of the script replacing the
+	// standard provisioning of the operating system.
+	CustomProvisionScriptUri string
+}
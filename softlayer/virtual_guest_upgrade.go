@@ -0,0 +1,32 @@
+package softlayer
+
+import (
+	"time"
+)
+
+const (
+	UPGRADE_CPUS_CATEGORY_CODE       = "guest_core"
+	UPGRADE_MEMORY_CATEGORY_CODE     = "ram"
+	UPGRADE_PORT_SPEED_CATEGORY_CODE = "port_speed"
+
+	UPGRADE_FIRST_DISK = 2
+	UPGRADE_LAST_DISK  = 5
+)
+
+// UpgradeOptions are the upgrades of a virtual guest ordered by
+// SoftLayer_Virtual_Guest_Service#Upgrade. The values left to 0 are not
+// upgraded, the other ones must be the capacity of one of the upgrade item
+// prices of the virtual guest.
+type UpgradeOptions struct {
+	Cpus          int
+	MemoryGb      int
+	PortSpeedMbps int
+
+	// Disks are the capacities in GB of the additional disks, by their
+	// number from UPGRADE_FIRST_DISK to UPGRADE_LAST_DISK, e.g. 2 for the
+	// guest_disk2 category.
+	Disks map[int]int
+
+	// MaintenanceWindow is when the upgrade is made, now when it is zero.
+	MaintenanceWindow time.Time
+}
@@ -0,0 +1,15 @@
+// Code generated by slgo-gen from the SoftLayer API metadata. DO NOT EDIT.
+
+package data_types
+
+// Every piece of hardware and network connection owned by SoftLayer is tracked
+// physically by location and stored in the SoftLayer_Location data type.
+type SoftLayer_Location struct {
+	// The unique identifier of a specific location.
+	Id int `json:"id,omitempty"`
+	// A longer location description.
+	LongName string `json:"longName,omitempty"`
+	// A short location description.
+	Name     string `json:"name,omitempty"`
+	StatusId int    `json:"statusId,omitempty"`
+}
@@ -0,0 +1,18 @@
+// Code generated by slgo-gen from the SoftLayer API metadata. DO NOT EDIT.
+
+package data_types
+
+// The SoftLayer_Network_Subnet data type contains general information relating
+// to a single SoftLayer subnet.
+type SoftLayer_Network_Subnet struct {
+	Cidr              int                                  `json:"cidr,omitempty"`
+	Gateway           string                               `json:"gateway,omitempty"`
+	Id                int                                  `json:"id,omitempty"`
+	IpAddresses       []SoftLayer_Network_Subnet_IpAddress `json:"ipAddresses,omitempty"`
+	IsCustomerOwned   bool                                 `json:"isCustomerOwned,omitempty"`
+	NetworkIdentifier string                               `json:"networkIdentifier,omitempty"`
+	NetworkVlan       *SoftLayer_Network_Vlan              `json:"networkVlan,omitempty"`
+	NetworkVlanId     int                                  `json:"networkVlanId,omitempty"`
+	Note              string                               `json:"note,omitempty"`
+	TotalIpAddresses  float64                              `json:"totalIpAddresses,omitempty"`
+}
@@ -0,0 +1,12 @@
+// Code generated by slgo-gen from the SoftLayer API metadata. DO NOT EDIT.
+
+package data_types
+
+type SoftLayer_Network_Subnet_IpAddress struct {
+	Id         int                       `json:"id,omitempty"`
+	IpAddress  string                    `json:"ipAddress,omitempty"`
+	IsReserved bool                      `json:"isReserved,omitempty"`
+	Subnet     *SoftLayer_Network_Subnet `json:"subnet,omitempty"`
+	SubnetId   int                       `json:"subnetId,omitempty"`
+	Tags       []string                  `json:"tags,omitempty"`
+}
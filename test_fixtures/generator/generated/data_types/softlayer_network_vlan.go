@@ -0,0 +1,24 @@
+// Code generated by slgo-gen from the SoftLayer API metadata. DO NOT EDIT.
+
+package data_types
+
+import (
+	"time"
+)
+
+// A virtual LAN, or VLAN, is a logical network segment within a SoftLayer
+// datacenter.
+type SoftLayer_Network_Vlan struct {
+	AccountId int `json:"accountId,omitempty"`
+	// A VLAN's internal identifier.
+	Id              int                        `json:"id,omitempty"`
+	ModifyDate      *time.Time                 `json:"modifyDate,omitempty"`
+	Name            string                     `json:"name,omitempty"`
+	Note            string                     `json:"note,omitempty"`
+	PrimaryRouter   *SoftLayer_Location        `json:"primaryRouter,omitempty"`
+	PrimarySubnet   *SoftLayer_Network_Subnet  `json:"primarySubnet,omitempty"`
+	PrimarySubnetId int                        `json:"primarySubnetId,omitempty"`
+	SubnetCount     uint64                     `json:"subnetCount,omitempty"`
+	Subnets         []SoftLayer_Network_Subnet `json:"subnets,omitempty"`
+	VlanNumber      int                        `json:"vlanNumber,omitempty"`
+}
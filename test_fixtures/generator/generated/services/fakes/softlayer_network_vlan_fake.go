@@ -0,0 +1,155 @@
+// Code generated by slgo-gen from the SoftLayer API metadata. DO NOT EDIT.
+
+package services_fakes
+
+import (
+	"context"
+	"sync"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	datatypes "github.com/maximilien/softlayer-go/test_fixtures/generator/generated/data_types"
+	services "github.com/maximilien/softlayer-go/test_fixtures/generator/generated/services"
+)
+
+var _ services.SoftLayer_Network_Vlan_Service = new(FakeSoftLayer_Network_Vlan_Service)
+
+type FakeSoftLayer_Network_Vlan_Service struct {
+	mutex sync.Mutex
+
+	EditObjectStub  func(ctx context.Context, id int, templateObject datatypes.SoftLayer_Network_Vlan, options *softlayer.RequestOptions) (bool, error)
+	EditObjectCalls []struct {
+		Ctx            context.Context
+		Id             int
+		TemplateObject datatypes.SoftLayer_Network_Vlan
+		Options        *softlayer.RequestOptions
+	}
+	EditObjectResult bool
+	EditObjectError  error
+
+	GetObjectStub  func(ctx context.Context, id int, options *softlayer.RequestOptions) (datatypes.SoftLayer_Network_Vlan, error)
+	GetObjectCalls []struct {
+		Ctx     context.Context
+		Id      int
+		Options *softlayer.RequestOptions
+	}
+	GetObjectResult datatypes.SoftLayer_Network_Vlan
+	GetObjectError  error
+
+	GetSubnetsStub  func(ctx context.Context, id int, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Network_Subnet, error)
+	GetSubnetsCalls []struct {
+		Ctx     context.Context
+		Id      int
+		Options *softlayer.RequestOptions
+	}
+	GetSubnetsResult []datatypes.SoftLayer_Network_Subnet
+	GetSubnetsError  error
+
+	SetTagsStub  func(ctx context.Context, id int, tags string, options *softlayer.RequestOptions) (bool, error)
+	SetTagsCalls []struct {
+		Ctx     context.Context
+		Id      int
+		Tags    string
+		Options *softlayer.RequestOptions
+	}
+	SetTagsResult bool
+	SetTagsError  error
+
+	UpgradeStub  func(ctx context.Context, id int, options *softlayer.RequestOptions) error
+	UpgradeCalls []struct {
+		Ctx     context.Context
+		Id      int
+		Options *softlayer.RequestOptions
+	}
+	UpgradeError error
+}
+
+func (fake *FakeSoftLayer_Network_Vlan_Service) GetName() string {
+	return "SoftLayer_Network_Vlan"
+}
+
+func (fake *FakeSoftLayer_Network_Vlan_Service) EditObject(ctx context.Context, id int, templateObject datatypes.SoftLayer_Network_Vlan, options *softlayer.RequestOptions) (bool, error) {
+	fake.mutex.Lock()
+	fake.EditObjectCalls = append(fake.EditObjectCalls, struct {
+		Ctx            context.Context
+		Id             int
+		TemplateObject datatypes.SoftLayer_Network_Vlan
+		Options        *softlayer.RequestOptions
+	}{ctx, id, templateObject, options})
+	stub := fake.EditObjectStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id, templateObject, options)
+	}
+
+	return fake.EditObjectResult, fake.EditObjectError
+}
+
+func (fake *FakeSoftLayer_Network_Vlan_Service) GetObject(ctx context.Context, id int, options *softlayer.RequestOptions) (datatypes.SoftLayer_Network_Vlan, error) {
+	fake.mutex.Lock()
+	fake.GetObjectCalls = append(fake.GetObjectCalls, struct {
+		Ctx     context.Context
+		Id      int
+		Options *softlayer.RequestOptions
+	}{ctx, id, options})
+	stub := fake.GetObjectStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id, options)
+	}
+
+	return fake.GetObjectResult, fake.GetObjectError
+}
+
+func (fake *FakeSoftLayer_Network_Vlan_Service) GetSubnets(ctx context.Context, id int, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Network_Subnet, error) {
+	fake.mutex.Lock()
+	fake.GetSubnetsCalls = append(fake.GetSubnetsCalls, struct {
+		Ctx     context.Context
+		Id      int
+		Options *softlayer.RequestOptions
+	}{ctx, id, options})
+	stub := fake.GetSubnetsStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id, options)
+	}
+
+	return fake.GetSubnetsResult, fake.GetSubnetsError
+}
+
+func (fake *FakeSoftLayer_Network_Vlan_Service) SetTags(ctx context.Context, id int, tags string, options *softlayer.RequestOptions) (bool, error) {
+	fake.mutex.Lock()
+	fake.SetTagsCalls = append(fake.SetTagsCalls, struct {
+		Ctx     context.Context
+		Id      int
+		Tags    string
+		Options *softlayer.RequestOptions
+	}{ctx, id, tags, options})
+	stub := fake.SetTagsStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id, tags, options)
+	}
+
+	return fake.SetTagsResult, fake.SetTagsError
+}
+
+func (fake *FakeSoftLayer_Network_Vlan_Service) Upgrade(ctx context.Context, id int, options *softlayer.RequestOptions) error {
+	fake.mutex.Lock()
+	fake.UpgradeCalls = append(fake.UpgradeCalls, struct {
+		Ctx     context.Context
+		Id      int
+		Options *softlayer.RequestOptions
+	}{ctx, id, options})
+	stub := fake.UpgradeStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id, options)
+	}
+
+	return fake.UpgradeError
+}
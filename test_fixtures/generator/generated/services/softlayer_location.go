@@ -0,0 +1,62 @@
+// Code generated by slgo-gen from the SoftLayer API metadata. DO NOT EDIT.
+
+package services
+
+import (
+	"context"
+
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	datatypes "github.com/maximilien/softlayer-go/test_fixtures/generator/generated/data_types"
+)
+
+// Every piece of hardware and network connection owned by SoftLayer is tracked
+// physically by location and stored in the SoftLayer_Location data type.
+type SoftLayer_Location_Service interface {
+	softlayer.Service
+
+	// Retrieve all datacenter locations.
+	GetDatacenters(ctx context.Context, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Location, error)
+	// Retrieve a SoftLayer_Location record.
+	GetObject(ctx context.Context, id int, options *softlayer.RequestOptions) (datatypes.SoftLayer_Location, error)
+}
+
+type softLayer_Location_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Location_Service(client softlayer.Client) *softLayer_Location_Service {
+	return &softLayer_Location_Service{
+		client: client,
+	}
+}
+
+func (slls *softLayer_Location_Service) GetName() string {
+	return "SoftLayer_Location"
+}
+
+func (slls *softLayer_Location_Service) GetDatacenters(ctx context.Context, options *softlayer.RequestOptions) ([]datatypes.SoftLayer_Location, error) {
+	invocation := softlayer.Invocation{
+		Service: slls.GetName(),
+		Method:  "getDatacenters",
+		Options: options,
+	}
+
+	var result []datatypes.SoftLayer_Location
+	err := slls.client.Invoke(ctx, invocation, &result)
+
+	return result, err
+}
+
+func (slls *softLayer_Location_Service) GetObject(ctx context.Context, id int, options *softlayer.RequestOptions) (datatypes.SoftLayer_Location, error) {
+	invocation := softlayer.Invocation{
+		Service: slls.GetName(),
+		Id:      id,
+		Method:  "getObject",
+		Options: options,
+	}
+
+	var result datatypes.SoftLayer_Location
+	err := slls.client.Invoke(ctx, invocation, &result)
+
+	return result, err
+}
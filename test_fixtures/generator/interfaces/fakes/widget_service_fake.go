@@ -0,0 +1,145 @@
+// Code generated by slgo-fakes. DO NOT EDIT.
+
+package interfaces_fakes
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	interfaces "github.com/maximilien/softlayer-go/test_fixtures/generator/interfaces"
+)
+
+var _ interfaces.Widget_Service = new(FakeWidget_Service)
+
+type FakeWidget_Service struct {
+	mutex sync.Mutex
+
+	CloseStub  func()
+	CloseCalls []struct{}
+
+	GetNameStub   func() string
+	GetNameCalls  []struct{}
+	GetNameResult string
+
+	GetWidgetStub  func(ctx context.Context, id int, options *interfaces.Options) (datatypes.SoftLayer_Virtual_Guest, error)
+	GetWidgetCalls []struct {
+		Ctx     context.Context
+		Id      int
+		Options *interfaces.Options
+	}
+	GetWidgetResult datatypes.SoftLayer_Virtual_Guest
+	GetWidgetError  error
+
+	LookupStub  func(arg1 map[string]interface{}, arg2 []int) bool
+	LookupCalls []struct {
+		Arg1 map[string]interface{}
+		Arg2 []int
+	}
+	LookupResult bool
+
+	SplitStub  func(reader io.Reader) (string, int, error)
+	SplitCalls []struct {
+		Reader io.Reader
+	}
+	SplitResult1 string
+	SplitResult2 int
+	SplitError   error
+
+	TagStub  func(id int, tags ...string) error
+	TagCalls []struct {
+		Id   int
+		Tags []string
+	}
+	TagError error
+}
+
+func (fake *FakeWidget_Service) Close() {
+	fake.mutex.Lock()
+	fake.CloseCalls = append(fake.CloseCalls, struct{}{})
+	stub := fake.CloseStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		stub()
+		return
+	}
+}
+
+func (fake *FakeWidget_Service) GetName() string {
+	fake.mutex.Lock()
+	fake.GetNameCalls = append(fake.GetNameCalls, struct{}{})
+	stub := fake.GetNameStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub()
+	}
+
+	return fake.GetNameResult
+}
+
+func (fake *FakeWidget_Service) GetWidget(ctx context.Context, id int, options *interfaces.Options) (datatypes.SoftLayer_Virtual_Guest, error) {
+	fake.mutex.Lock()
+	fake.GetWidgetCalls = append(fake.GetWidgetCalls, struct {
+		Ctx     context.Context
+		Id      int
+		Options *interfaces.Options
+	}{ctx, id, options})
+	stub := fake.GetWidgetStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(ctx, id, options)
+	}
+
+	return fake.GetWidgetResult, fake.GetWidgetError
+}
+
+func (fake *FakeWidget_Service) Lookup(arg1 map[string]interface{}, arg2 []int) bool {
+	fake.mutex.Lock()
+	fake.LookupCalls = append(fake.LookupCalls, struct {
+		Arg1 map[string]interface{}
+		Arg2 []int
+	}{arg1, arg2})
+	stub := fake.LookupStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+
+	return fake.LookupResult
+}
+
+func (fake *FakeWidget_Service) Split(reader io.Reader) (string, int, error) {
+	fake.mutex.Lock()
+	fake.SplitCalls = append(fake.SplitCalls, struct {
+		Reader io.Reader
+	}{reader})
+	stub := fake.SplitStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(reader)
+	}
+
+	return fake.SplitResult1, fake.SplitResult2, fake.SplitError
+}
+
+func (fake *FakeWidget_Service) Tag(id int, tags ...string) error {
+	fake.mutex.Lock()
+	fake.TagCalls = append(fake.TagCalls, struct {
+		Id   int
+		Tags []string
+	}{id, tags})
+	stub := fake.TagStub
+	fake.mutex.Unlock()
+
+	if stub != nil {
+		return stub(id, tags...)
+	}
+
+	return fake.TagError
+}
@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type Named interface {
+	GetName() string
+}
+
+type Options struct {
+	Verbose bool
+}
+
+type Widget_Service interface {
+	Named
+
+	Close()
+	GetWidget(ctx context.Context, id int, options *Options) (datatypes.SoftLayer_Virtual_Guest, error)
+	Tag(id int, tags ...string) error
+	Split(reader io.Reader) (string, int, error)
+	Lookup(map[string]interface{}, []int) bool
+}
+
+type unexported interface {
+	Ignored()
+}
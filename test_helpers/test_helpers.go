@@ -1,12 +1,14 @@
 package test_helpers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"time"
 
@@ -15,6 +17,8 @@ import (
 	. "github.com/onsi/gomega/gexec"
 
 	slclient "github.com/maximilien/softlayer-go/client"
+	recorder "github.com/maximilien/softlayer-go/client/recorder"
+	simulator "github.com/maximilien/softlayer-go/client/simulator"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
@@ -24,7 +28,21 @@ var (
 	POLLING_INTERVAL time.Duration
 )
 
+var (
+	slRecorder  *recorder.Recorder
+	slSimulator *simulator.Server
+)
+
 const (
+	SL_RECORDER_MODE = "SL_RECORDER_MODE"
+	SL_SIMULATOR     = "SL_SIMULATOR"
+
+	// OFFLINE_POLLING_INTERVAL replaces POLLING_INTERVAL when replaying or
+	// simulating since the states then follow each other without waiting.
+	OFFLINE_POLLING_INTERVAL = 10 * time.Millisecond
+
+	SIMULATOR_TRANSACTION_DURATION = 100 * time.Millisecond
+
 	TEST_NOTES_PREFIX = "TEST:softlayer-go"
 	TEST_LABEL_PREFIX = "TEST:softlayer-go"
 
@@ -38,7 +56,11 @@ func FindTestVirtualGuests() ([]datatypes.SoftLayer_Virtual_Guest, error) {
 		return []datatypes.SoftLayer_Virtual_Guest{}, err
 	}
 
-	virtualGuests, err := accountService.GetVirtualGuests()
+	options := &softlayer.RequestOptions{
+		Filter: softlayer.NewObjectFilter().Set("virtualGuests.notes", softlayer.Contains(TEST_NOTES_PREFIX)),
+	}
+
+	virtualGuests, err := accountService.GetVirtualGuestsWithOptions(context.Background(), options)
 	if err != nil {
 		return []datatypes.SoftLayer_Virtual_Guest{}, err
 	}
@@ -117,26 +139,108 @@ func FindTestSshKeys() ([]datatypes.SoftLayer_Security_Ssh_Key, error) {
 }
 
 func GetUsernameAndApiKey() (string, string, error) {
-	username := os.Getenv("SL_USERNAME")
-	if username == "" {
-		return "", "", errors.New("SL_USERNAME environment must be set")
+	config, err := slclient.LoadConfig("")
+	if err != nil {
+		return "", "", err
+	}
+
+	return config.Username, config.ApiKey, nil
+}
+
+// StartRecorder makes the clients returned by CreateClient record their calls
+// into, or replay them from, the cassette name in test_fixtures/cassettes,
+// depending on the SL_RECORDER_MODE environment variable, until StopRecorder
+// is called. The clients call the API as usual when SL_RECORDER_MODE is not
+// set.
+func StartRecorder(name string) error {
+	mode := os.Getenv(SL_RECORDER_MODE)
+	if mode == "" {
+		return nil
+	}
+
+	username, apiKey, err := GetUsernameAndApiKey()
+	if err != nil {
+		if recorder.Mode(mode) != recorder.MODE_REPLAY {
+			return err
+		}
+
+		username, apiKey = "fake-username", "fake-api-key"
 	}
 
-	apiKey := os.Getenv("SL_API_KEY")
-	if apiKey == "" {
-		return username, "", errors.New("SL_API_KEY environment must be set")
+	_, filename, _, _ := runtime.Caller(0)
+	cassettePath := filepath.Join(filepath.Dir(filename), "..", "test_fixtures", "cassettes", name+".json")
+
+	slRecorder, err = recorder.New(cassettePath, recorder.Mode(mode), nil, username, apiKey)
+	if err != nil {
+		return err
 	}
 
-	return username, apiKey, nil
+	return nil
 }
 
-func CreateAccountService() (softlayer.SoftLayer_Account_Service, error) {
+// StopRecorder saves the cassette when recording.
+func StopRecorder() error {
+	if slRecorder == nil {
+		return nil
+	}
+
+	err := slRecorder.Stop()
+	slRecorder = nil
+
+	return err
+}
+
+// StartSimulator starts an in-process SoftLayer API simulator that the
+// clients call instead of the API when SL_SIMULATOR is set.
+func StartSimulator() {
+	if os.Getenv(SL_SIMULATOR) == "" {
+		return
+	}
+
+	slSimulator = simulator.NewServer(simulator.Config{
+		TransactionDuration: SIMULATOR_TRANSACTION_DURATION,
+	})
+}
+
+// StopSimulator stops the simulator started by StartSimulator.
+func StopSimulator() {
+	if slSimulator == nil {
+		return
+	}
+
+	slSimulator.Close()
+	slSimulator = nil
+}
+
+func CreateClient() (softlayer.Client, error) {
+	options := []slclient.ClientOption{}
+	if slRecorder != nil {
+		options = append(options, slclient.WithTransport(slRecorder))
+	}
+
+	if slSimulator != nil {
+		options = append(options, slclient.WithEndpoint(slSimulator.URL))
+		return slclient.NewSoftLayerClient("fake-username", "fake-api-key", options...), nil
+	}
+
+	if slRecorder != nil && slRecorder.Mode() == recorder.MODE_REPLAY {
+		return slclient.NewSoftLayerClient("fake-username", "fake-api-key", options...), nil
+	}
+
 	username, apiKey, err := GetUsernameAndApiKey()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
+	return slclient.NewSoftLayerClient(username, apiKey, options...), nil
+}
+
+func CreateAccountService() (softlayer.SoftLayer_Account_Service, error) {
+	client, err := CreateClient()
+	if err != nil {
+		return nil, err
+	}
+
 	accountService, err := client.GetSoftLayer_Account_Service()
 	if err != nil {
 		return nil, err
@@ -146,12 +250,11 @@ func CreateAccountService() (softlayer.SoftLayer_Account_Service, error) {
 }
 
 func CreateVirtualGuestService() (softlayer.SoftLayer_Virtual_Guest_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	virtualGuestService, err := client.GetSoftLayer_Virtual_Guest_Service()
 	if err != nil {
 		return nil, err
@@ -161,12 +264,11 @@ func CreateVirtualGuestService() (softlayer.SoftLayer_Virtual_Guest_Service, err
 }
 
 func CreateSecuritySshKeyService() (softlayer.SoftLayer_Security_Ssh_Key_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	sshKeyService, err := client.GetSoftLayer_Security_Ssh_Key_Service()
 	if err != nil {
 		return nil, err
@@ -177,12 +279,11 @@ func CreateSecuritySshKeyService() (softlayer.SoftLayer_Security_Ssh_Key_Service
 
 // TODO: need to refine and merge the CreateXXXService in test_helpers
 func CreateProductPackageService() (softlayer.SoftLayer_Product_Package_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	productPackageService, err := client.GetSoftLayer_Product_Package_Service()
 	if err != nil {
 		return nil, err
@@ -192,12 +293,11 @@ func CreateProductPackageService() (softlayer.SoftLayer_Product_Package_Service,
 }
 
 func CreateNetworkStorageService() (softlayer.SoftLayer_Network_Storage_Service, error) {
-	username, apiKey, err := GetUsernameAndApiKey()
+	client, err := CreateClient()
 	if err != nil {
 		return nil, err
 	}
 
-	client := slclient.NewSoftLayerClient(username, apiKey)
 	networkStorageService, err := client.GetSoftLayer_Network_Storage_Service()
 	if err != nil {
 		return nil, err
@@ -390,7 +490,7 @@ func WaitForVirtualGuest(virtualGuestId int, targetState string, timeout time.Du
 		Expect(err).ToNot(HaveOccurred())
 		fmt.Printf("----> virtual guest: %d, has power state: %s\n", virtualGuestId, vgPowerState.KeyName)
 		return vgPowerState.KeyName
-	}, timeout, POLLING_INTERVAL).Should(Equal(targetState), fmt.Sprintf("failed waiting for virtual guest to be %s", targetState))
+	}, timeout, pollingInterval()).Should(Equal(targetState), fmt.Sprintf("failed waiting for virtual guest to be %s", targetState))
 }
 
 func WaitForVirtualGuestToBeRunning(virtualGuestId int) {
@@ -407,7 +507,7 @@ func WaitForVirtualGuestToHaveNoActiveTransactions(virtualGuestId int) {
 		Expect(err).ToNot(HaveOccurred())
 		fmt.Printf("----> virtual guest: %d, has %d active transactions\n", virtualGuestId, len(activeTransactions))
 		return len(activeTransactions)
-	}, TIMEOUT, POLLING_INTERVAL).Should(Equal(0), "failed waiting for virtual guest to have no active transactions")
+	}, TIMEOUT, pollingInterval()).Should(Equal(0), "failed waiting for virtual guest to have no active transactions")
 }
 
 func WaitForDeletedSshKeyToNoLongerBePresent(sshKeyId int) {
@@ -426,7 +526,7 @@ func WaitForDeletedSshKeyToNoLongerBePresent(sshKeyId int) {
 			}
 		}
 		return deleted
-	}, TIMEOUT, POLLING_INTERVAL).Should(BeTrue(), "failed waiting for deleted ssh key to be removed from list of ssh keys")
+	}, TIMEOUT, pollingInterval()).Should(BeTrue(), "failed waiting for deleted ssh key to be removed from list of ssh keys")
 }
 
 func WaitForCreatedSshKeyToBePresent(sshKeyId int) {
@@ -445,7 +545,7 @@ func WaitForCreatedSshKeyToBePresent(sshKeyId int) {
 			}
 		}
 		return keyPresent
-	}, TIMEOUT, POLLING_INTERVAL).Should(BeTrue(), "created ssh key but not in the list of ssh keys")
+	}, TIMEOUT, pollingInterval()).Should(BeTrue(), "created ssh key but not in the list of ssh keys")
 }
 
 func SetUserDataToVirtualGuest(virtualGuestId int, metadata string) {
@@ -477,6 +577,14 @@ func SetUserMetadataAndConfigureDisk(virtualGuestId int, userMetadata string) da
 	return transaction
 }
 
+func pollingInterval() time.Duration {
+	if slSimulator != nil || (slRecorder != nil && slRecorder.Mode() == recorder.MODE_REPLAY) {
+		return OFFLINE_POLLING_INTERVAL
+	}
+
+	return POLLING_INTERVAL
+}
+
 func RunCommand(timeout time.Duration, cmd string, args ...string) *Session {
 	command := exec.Command(cmd, args...)
 	session, err := Start(command, GinkgoWriter, GinkgoWriter)
@@ -538,7 +646,7 @@ func WaitForIscsiStorageToBeDeleted(storageId int) {
 			}
 		}
 		return deletedFlag
-	}, TIMEOUT, POLLING_INTERVAL).Should(BeTrue(), "created iSCSI volume but not deleted successfully")
+	}, TIMEOUT, pollingInterval()).Should(BeTrue(), "created iSCSI volume but not deleted successfully")
 }
 
 func GetVirtualGuestPrimaryIpAddress(virtualGuestId int) string {